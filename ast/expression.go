@@ -46,26 +46,60 @@ func (e BinaryExpression) GetLocs() []globals.Loc {
 	return locs
 }
 
+type RangeExpression struct {
+	Start    Expression
+	Operator tokens.Token
+	Stop     Expression
+	Step     Expression
+}
+
+func (e RangeExpression) Inclusive() bool {
+	return e.Operator.Type == tokens.DOT_DOT_EQUAL
+}
+
+func (e RangeExpression) Reference() string {
+	if e.Step != nil {
+		return fmt.Sprintf("%s%s%s step %s", e.Start.Reference(), e.Operator.Lexeme, e.Stop.Reference(), e.Step.Reference())
+	}
+
+	return fmt.Sprintf("%s%s%s", e.Start.Reference(), e.Operator.Lexeme, e.Stop.Reference())
+}
+
+func (e RangeExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{}
+
+	locs = append(locs, e.Start.GetLocs()...)
+	locs = append(locs, e.Operator.Loc)
+	locs = append(locs, e.Stop.GetLocs()...)
+
+	if e.Step != nil {
+		locs = append(locs, e.Step.GetLocs()...)
+	}
+
+	return locs
+}
+
 type IsExpression struct {
 	Expr     Expression
-	Expected tokens.Token
+	Expected TypeAnnotation
 }
 
 func (e IsExpression) Reference() string {
-	return e.Expr.Reference() + " is " + e.Expected.Lexeme
+	return e.Expr.Reference() + " is " + e.Expected.Reference()
 }
 
 func (e IsExpression) GetLocs() []globals.Loc {
 	locs := []globals.Loc{}
 
 	locs = append(locs, e.Expr.GetLocs()...)
-	locs = append(locs, e.Expr.GetLocs()...)
+	locs = append(locs, e.Expected.GetLocs()...)
 	return locs
 }
 
 type CallExpression struct {
 	Callee    Expression
 	Arguments []Expression
+	Optional  bool
 }
 
 func (e CallExpression) Reference() string {
@@ -77,6 +111,10 @@ func (e CallExpression) Reference() string {
 		}
 	}
 
+	if e.Optional {
+		return e.Callee.Reference() + "?.(" + arguments + ")"
+	}
+
 	return e.Callee.Reference() + "(" + arguments + ")"
 }
 
@@ -94,6 +132,105 @@ func (e CallExpression) GetLocs() []globals.Loc {
 	return locs
 }
 
+type PropagateExpression struct {
+	Expression Expression
+	Operator   tokens.Token
+}
+
+func (e PropagateExpression) Reference() string {
+	return e.Expression.Reference() + "?"
+}
+
+func (e PropagateExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{}
+
+	locs = append(locs, e.Expression.GetLocs()...)
+	locs = append(locs, e.Operator.Loc)
+
+	return locs
+}
+
+type IfExpression struct {
+	Keyword    tokens.Token
+	Condition  Expression
+	ThenBranch Statement
+	ElseBranch Statement
+}
+
+func (e IfExpression) Reference() string {
+	if e.ElseBranch != nil {
+		return "if " + e.Condition.Reference() + " { ... } else { ... }"
+	}
+
+	return "if " + e.Condition.Reference() + " { ... }"
+}
+
+func (e IfExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{e.Keyword.Loc}
+
+	locs = append(locs, e.Condition.GetLocs()...)
+
+	return locs
+}
+
+type OptionalChainExpression struct {
+	Expression Expression
+}
+
+func (e OptionalChainExpression) Reference() string {
+	return e.Expression.Reference()
+}
+
+func (e OptionalChainExpression) GetLocs() []globals.Loc {
+	return e.Expression.GetLocs()
+}
+
+type AssertExpression struct {
+	Expression Expression
+	Operator   tokens.Token
+}
+
+func (e AssertExpression) Reference() string {
+	return e.Expression.Reference() + "!"
+}
+
+func (e AssertExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{}
+
+	locs = append(locs, e.Expression.GetLocs()...)
+	locs = append(locs, e.Operator.Loc)
+
+	return locs
+}
+
+type MatchCaseParameter struct {
+	Name tokens.Token
+}
+
+type MatchCase struct {
+	Expression Expression
+	Callback   FunctionExpression
+	Guard      Expression
+	Body       Statement
+}
+
+type MatchExpression struct {
+	Expression Expression
+	Cases      []MatchCase
+}
+
+func (e MatchExpression) Reference() string {
+	return "match " + e.Expression.Reference() + " { ... }"
+}
+
+func (e MatchExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{}
+
+	locs = append(locs, e.Expression.GetLocs()...)
+
+	return locs
+}
+
 type GroupingExpression struct {
 	Expression Expression
 }
@@ -120,6 +257,36 @@ func (e LiteralExpression) GetLocs() []globals.Loc {
 	return []globals.Loc{e.Loc}
 }
 
+type TemplateExpression struct {
+	Loc   globals.Loc
+	Parts []Expression
+}
+
+func (e TemplateExpression) Reference() string {
+	result := "\""
+
+	for _, part := range e.Parts {
+		if literal, ok := part.(LiteralExpression); ok {
+			result += fmt.Sprint(literal.Value)
+			continue
+		}
+
+		result += "${" + part.Reference() + "}"
+	}
+
+	return result + "\""
+}
+
+func (e TemplateExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{e.Loc}
+
+	for _, part := range e.Parts {
+		locs = append(locs, part.GetLocs()...)
+	}
+
+	return locs
+}
+
 type TypeConversionExpression struct {
 	Type  tokens.Token
 	Value Expression
@@ -212,20 +379,27 @@ func (e ArrayExpression) GetLocs() []globals.Loc {
 	return locs
 }
 
+// a nil Key marks a spread entry held in Value
+type HashmapPair struct {
+	Key   Expression
+	Value Expression
+}
+
 type HashmapExpression struct {
-	Pairs map[Expression]Expression
+	Pairs []HashmapPair
 }
 
 func (e HashmapExpression) Reference() string {
 	arguments := ""
-	index := 0
-	for key, value := range e.Pairs {
-		arguments += key.Reference() + ": " + value.Reference()
+	for index, pair := range e.Pairs {
+		if pair.Key != nil {
+			arguments += pair.Key.Reference() + ": "
+		}
+
+		arguments += pair.Value.Reference()
 		if index < len(e.Pairs)-1 {
 			arguments += ", "
 		}
-
-		index++
 	}
 	return "{" + arguments + "}"
 }
@@ -233,10 +407,87 @@ func (e HashmapExpression) Reference() string {
 func (e HashmapExpression) GetLocs() []globals.Loc {
 	locs := []globals.Loc{}
 
-	for key, value := range e.Pairs {
-		locs = append(locs, key.GetLocs()...)
-		locs = append(locs, value.GetLocs()...)
+	for _, pair := range e.Pairs {
+		if pair.Key != nil {
+			locs = append(locs, pair.Key.GetLocs()...)
+		}
+		locs = append(locs, pair.Value.GetLocs()...)
+	}
+	return locs
+}
+
+type NamedArgument struct {
+	Name  tokens.Token
+	Value Expression
+}
+
+func (e NamedArgument) Reference() string {
+	return e.Name.Lexeme + ": " + e.Value.Reference()
+}
+
+func (e NamedArgument) GetLocs() []globals.Loc {
+	locs := []globals.Loc{e.Name.Loc}
+
+	locs = append(locs, e.Value.GetLocs()...)
+
+	return locs
+}
+
+type SpreadExpression struct {
+	Operator   tokens.Token
+	Expression Expression
+}
+
+func (e SpreadExpression) Reference() string {
+	return "..." + e.Expression.Reference()
+}
+
+func (e SpreadExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{e.Operator.Loc}
+
+	locs = append(locs, e.Expression.GetLocs()...)
+
+	return locs
+}
+
+type SliceExpression struct {
+	Object   Expression
+	Start    Expression
+	End      Expression
+	Optional bool
+}
+
+func (e SliceExpression) Reference() string {
+	bounds := ":"
+
+	if e.Start != nil {
+		bounds = e.Start.Reference() + bounds
+	}
+
+	if e.End != nil {
+		bounds += e.End.Reference()
+	}
+
+	hook := ""
+	if e.Optional {
+		hook = "?"
+	}
+
+	return e.Object.Reference() + hook + "[" + bounds + "]"
+}
+
+func (e SliceExpression) GetLocs() []globals.Loc {
+	locs := []globals.Loc{}
+	locs = append(locs, e.Object.GetLocs()...)
+
+	if e.Start != nil {
+		locs = append(locs, e.Start.GetLocs()...)
+	}
+
+	if e.End != nil {
+		locs = append(locs, e.End.GetLocs()...)
 	}
+
 	return locs
 }
 
@@ -252,14 +503,20 @@ type MemberExpression struct {
 	Property Expression
 	Computed bool
 	Type     MemberExpressionType
+	Optional bool
 }
 
 func (e MemberExpression) Reference() string {
+	hook := ""
+	if e.Optional {
+		hook = "?"
+	}
+
 	if e.Type == DotMember {
-		return e.Object.Reference() + "." + e.Property.Reference()
+		return e.Object.Reference() + hook + "." + e.Property.Reference()
 	}
 
-	return e.Object.Reference() + "[" + e.Property.Reference() + "]"
+	return e.Object.Reference() + hook + "[" + e.Property.Reference() + "]"
 }
 
 func (e MemberExpression) GetLocs() []globals.Loc {
@@ -289,25 +546,40 @@ func (e NamespaceMemberExpression) GetLocs() []globals.Loc {
 
 type FunctionExpression struct {
 	Name       tokens.Token
+	Receiver   tokens.Token
 	Params     []Parameter
-	ReturnType tokens.Token
+	ReturnType TypeAnnotation
 	Body       []Statement
 }
 
-func (e FunctionExpression) Reference() string {
+func (e FunctionExpression) Signature() string {
 	params := ""
 
 	for i, param := range e.Params {
+		params += param.Name.Lexeme + " "
 		if param.Variadic {
 			params += "..."
 		}
-		params += param.Name.Lexeme + " " + string(param.Type)
+		params += param.Type.Reference()
+		if param.Default != nil {
+			params += " = " + param.Default.Reference()
+		}
 		if i < len(e.Params)-1 {
 			params += ", "
 		}
 	}
 
-	return "def " + e.Name.Lexeme + "(" + params + ") " + e.ReturnType.Lexeme + " { ... }"
+	name := e.Name.Lexeme
+
+	if e.Receiver.Lexeme != "" {
+		name = e.Receiver.Lexeme + "." + name
+	}
+
+	return name + "(" + params + ") " + e.ReturnType.Reference()
+}
+
+func (e FunctionExpression) Reference() string {
+	return "def " + e.Signature() + " { ... }"
 }
 
 func (e FunctionExpression) GetLocs() []globals.Loc {
@@ -318,7 +590,7 @@ func (e FunctionExpression) GetLocs() []globals.Loc {
 		paramsLocs = append(paramsLocs, param.Name.Loc)
 	}
 	locs = append(locs, paramsLocs...)
-	locs = append(locs, e.ReturnType.Loc)
+	locs = append(locs, e.ReturnType.GetLocs()...)
 
 	return locs
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"slices"
 	"strconv"
 
 	"github.com/pmqueiroz/umbra/exception"
@@ -43,6 +44,19 @@ func (p *Parser) check(tokenType tokens.TokenType) bool {
 	return p.peek().Type == tokenType
 }
 
+func (p *Parser) checkNext(types ...tokens.TokenType) bool {
+	if p.current+1 >= len(p.tokenList) {
+		return false
+	}
+
+	for _, t := range types {
+		if p.tokenList[p.current+1].Type == t {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Parser) match(types ...tokens.TokenType) bool {
 	for _, t := range types {
 		if p.check(t) {
@@ -75,6 +89,100 @@ func (p *Parser) throw(message string) {
 	os.Exit(1)
 }
 
+func (p *Parser) typeAnnotation(errorMessage string, allowed ...tokens.TokenType) TypeAnnotation {
+	annotation := p.singleTypeAnnotation(errorMessage, allowed...)
+
+	if !p.check(tokens.PIPE) {
+		return annotation
+	}
+
+	union := TypeAnnotation{
+		Token:   annotation.Token,
+		Members: []TypeAnnotation{annotation},
+	}
+
+	for p.match(tokens.PIPE) {
+		union.Members = append(union.Members, p.singleTypeAnnotation("Expect union member type.", tokens.DATA_TYPES...))
+	}
+
+	return union
+}
+
+// the return type of a bodiless signature has to be on the line of its closing parenthesis
+func (p *Parser) signatureReturnType(closing tokens.Token) TypeAnnotation {
+	returnTypes := append([]tokens.TokenType{tokens.VOID_TYPE}, tokens.DATA_TYPES...)
+
+	if !p.isAtEOF() && slices.Contains(returnTypes, p.peek().Type) && p.peek().Loc.Line == closing.Loc.Line {
+		return p.singleTypeAnnotation("Expect return type.", returnTypes...)
+	}
+
+	return TypeAnnotation{
+		Token: tokens.Token{
+			Type:   tokens.VOID_TYPE,
+			Loc:    closing.Loc,
+			Lexeme: "void",
+		},
+	}
+}
+
+func (p *Parser) singleTypeAnnotation(errorMessage string, allowed ...tokens.TokenType) TypeAnnotation {
+	annotation := TypeAnnotation{
+		Token: p.consume(errorMessage, allowed...),
+	}
+
+	if annotation.Token.Type == tokens.FUN_TYPE && p.match(tokens.LEFT_PARENTHESIS) {
+		if !p.check(tokens.RIGHT_PARENTHESIS) {
+			for {
+				annotation.Arguments = append(annotation.Arguments, p.typeAnnotation("Expect parameter type.", tokens.DATA_TYPES...))
+
+				if !p.match(tokens.COMMA) {
+					break
+				}
+			}
+		}
+
+		closing := p.consume("Expect ')' after parameter types.", tokens.RIGHT_PARENTHESIS)
+		returnType := p.signatureReturnType(closing)
+		annotation.Return = &returnType
+	} else if p.match(tokens.LESS_THAN) {
+		var arity int
+
+		switch annotation.Token.Type {
+		case tokens.ARR_TYPE:
+			arity = 1
+		case tokens.HASHMAP_TYPE:
+			arity = 2
+		default:
+			p.throw("Only arr and hashmap types accept type arguments.")
+		}
+
+		for {
+			annotation.Arguments = append(annotation.Arguments, p.typeAnnotation("Expect type argument.", tokens.DATA_TYPES...))
+
+			if !p.match(tokens.COMMA) {
+				break
+			}
+		}
+
+		if p.check(tokens.GREATER_GREATER) {
+			// `>>` closes two nested argument lists, leave one '>' for the outer one
+			p.tokenList[p.current].Type = tokens.GREATER_THAN
+			p.tokenList[p.current].Lexeme = ">"
+			p.tokenList[p.current].Loc.Range.From++
+		} else {
+			p.consume("Expect '>' after type arguments.", tokens.GREATER_THAN)
+		}
+
+		if len(annotation.Arguments) != arity {
+			p.throw(fmt.Sprintf("Expect %d type arguments for %s.", arity, annotation.Token.Lexeme))
+		}
+	}
+
+	annotation.Nullable = p.match(tokens.HOOK)
+
+	return annotation
+}
+
 func (p *Parser) block() (Statement, []Statement) {
 	var statements []Statement
 
@@ -89,50 +197,87 @@ func (p *Parser) block() (Statement, []Statement) {
 	}, statements
 }
 
-func (p *Parser) function() Statement {
-	name := p.consume("Expect function name.", tokens.IDENTIFIER)
+func (p *Parser) parameters(end tokens.TokenType) []Parameter {
+	var params []Parameter
 
-	p.consume("Expect '(' after function name.", tokens.LEFT_PARENTHESIS)
+	if p.check(end) {
+		return params
+	}
 
-	var params []Parameter
+	for {
+		param := Parameter{
+			Name: p.consume("Expect parameter name.", tokens.IDENTIFIER),
+		}
 
-	if !p.check(tokens.RIGHT_PARENTHESIS) {
-		for {
-			paramName := p.consume("Expect parameter name.", tokens.IDENTIFIER)
-			variadic := p.match(tokens.VARIADIC)
-			paramType := p.consume("Expect parameter type.", tokens.DATA_TYPES...)
-			parsedParamType, err := types.ParseTokenType(paramType.Type)
+		param.Variadic = p.match(tokens.VARIADIC)
 
-			if err != nil {
-				p.throw("Invalid parameter type.")
+		if end == tokens.PIPE {
+			param.Type = p.singleTypeAnnotation("Expect parameter type.", tokens.DATA_TYPES...)
+		} else {
+			param.Type = p.typeAnnotation("Expect parameter type.", tokens.DATA_TYPES...)
+		}
+
+		if p.match(tokens.EQUAL) {
+			if param.Variadic {
+				p.throw("Variadic parameters cannot have a default value.")
 			}
 
-			nullable := p.match(tokens.HOOK)
-			params = append(params, Parameter{
-				Name:     paramName,
-				Type:     parsedParamType,
-				Variadic: variadic,
-				Nullable: nullable,
-			})
+			if end == tokens.PIPE {
+				param.Default = p.bitwiseXor()
+			} else {
+				param.Default = p.expression()
+			}
+		} else if len(params) > 0 && params[len(params)-1].Default != nil && !param.Variadic {
+			p.throw("Parameters without a default value cannot follow parameters with one.")
+		}
 
-			if !p.match(tokens.COMMA) {
-				break
+		for _, previous := range params {
+			if previous.Variadic {
+				p.throw("Variadic parameter must be the last one.")
+			}
+
+			if previous.Name.Lexeme == param.Name.Lexeme {
+				p.throw("Duplicate parameter name.")
 			}
 		}
+
+		params = append(params, param)
+
+		if !p.match(tokens.COMMA) {
+			break
+		}
+	}
+
+	return params
+}
+
+func (p *Parser) function() Statement {
+	name := p.consume("Expect function name.", tokens.IDENTIFIER)
+	var receiver tokens.Token
+
+	if p.match(tokens.DOT) {
+		receiver = name
+		name = p.consume("Expect method name.", tokens.IDENTIFIER)
 	}
 
+	p.consume("Expect '(' after function name.", tokens.LEFT_PARENTHESIS)
+
+	params := p.parameters(tokens.RIGHT_PARENTHESIS)
+
 	p.consume("Expect ')' after parameters.", tokens.RIGHT_PARENTHESIS)
 
-	var returnType tokens.Token
+	var returnType TypeAnnotation
 
 	if !p.check(tokens.LEFT_BRACE) {
-		returnType = p.consume("Expect return type.", append([]tokens.TokenType{tokens.VOID_TYPE}, tokens.DATA_TYPES...)...)
+		returnType = p.typeAnnotation("Expect return type.", append([]tokens.TokenType{tokens.VOID_TYPE}, tokens.DATA_TYPES...)...)
 	} else {
 		currentToken := p.peek()
-		returnType = tokens.Token{
-			Type:   tokens.VOID_TYPE,
-			Loc:    currentToken.Loc,
-			Lexeme: currentToken.Lexeme,
+		returnType = TypeAnnotation{
+			Token: tokens.Token{
+				Type:   tokens.VOID_TYPE,
+				Loc:    currentToken.Loc,
+				Lexeme: "void",
+			},
 		}
 	}
 
@@ -142,18 +287,44 @@ func (p *Parser) function() Statement {
 
 	return FunctionExpression{
 		Name:       name,
+		Receiver:   receiver,
 		Params:     params,
 		ReturnType: returnType,
 		Body:       body,
 	}
 }
 
+func (p *Parser) argument() Expression {
+	if p.check(tokens.IDENTIFIER) && p.checkNext(tokens.COLON) {
+		name := p.advance()
+		p.advance()
+
+		return NamedArgument{
+			Name:  name,
+			Value: p.expression(),
+		}
+	}
+
+	return p.spreadable()
+}
+
+func (p *Parser) spreadable() Expression {
+	if p.match(tokens.VARIADIC) {
+		return SpreadExpression{
+			Operator:   p.previous(),
+			Expression: p.expression(),
+		}
+	}
+
+	return p.expression()
+}
+
 func (p *Parser) array() Expression {
 	var elements []Expression
 
 	if !p.check(tokens.RIGHT_BRACKET) {
 		for {
-			elements = append(elements, p.expression())
+			elements = append(elements, p.spreadable())
 
 			if !p.match(tokens.COMMA) || p.check(tokens.RIGHT_BRACKET) {
 				break
@@ -169,14 +340,21 @@ func (p *Parser) array() Expression {
 }
 
 func (p *Parser) hashmap() Expression {
-	properties := make(map[Expression]Expression)
+	var properties []HashmapPair
 
 	if !p.check(tokens.RIGHT_BRACE) {
 		for {
-			name := p.consume("Expect property name.", tokens.IDENTIFIER, tokens.STRING)
-			p.consume("Expect ':' after property identifier in hashmap", tokens.COLON)
+			if p.check(tokens.VARIADIC) {
+				properties = append(properties, HashmapPair{Value: p.spreadable()})
+			} else {
+				name := p.consume("Expect property name.", tokens.IDENTIFIER, tokens.STRING)
+				p.consume("Expect ':' after property identifier in hashmap", tokens.COLON)
 
-			properties[LiteralExpression{Loc: name.Loc, Value: name.Lexeme, Lexeme: name.Lexeme}] = p.expression()
+				properties = append(properties, HashmapPair{
+					Key:   LiteralExpression{Loc: name.Loc, Value: name.Lexeme, Lexeme: name.Lexeme},
+					Value: p.expression(),
+				})
+			}
 
 			if !p.match(tokens.COMMA) || p.check(tokens.RIGHT_BRACE) {
 				break
@@ -192,7 +370,7 @@ func (p *Parser) hashmap() Expression {
 }
 
 func (p *Parser) numeric() Expression {
-	value, err := strconv.ParseFloat(p.previous().Lexeme, 64)
+	value, err := tokens.ParseNumeric(p.previous().Lexeme)
 
 	if err != nil {
 		p.throw("Unable to convert number.")
@@ -205,6 +383,31 @@ func (p *Parser) numeric() Expression {
 	}
 }
 
+func (p *Parser) template() Expression {
+	template := TemplateExpression{
+		Loc: p.previous().Loc,
+	}
+
+	for !p.check(tokens.TEMPLATE_END) && !p.isAtEOF() {
+		if p.match(tokens.STRING) {
+			template.Parts = append(template.Parts, LiteralExpression{
+				Loc:    p.previous().Loc,
+				Lexeme: p.previous().Lexeme,
+				Value:  p.previous().Lexeme,
+			})
+			continue
+		}
+
+		p.consume("Expect '${' in string.", tokens.INTERPOLATION)
+		template.Parts = append(template.Parts, p.expression())
+		p.consume("Expect '}' after interpolated expression.", tokens.INTERPOLATION_END)
+	}
+
+	p.consume("Unterminated string.", tokens.TEMPLATE_END)
+
+	return template
+}
+
 func (p *Parser) primary() Expression {
 	if p.match(tokens.FALSE) {
 		return LiteralExpression{
@@ -250,6 +453,14 @@ func (p *Parser) primary() Expression {
 		}
 	}
 
+	if p.match(tokens.TEMPLATE_START) {
+		return p.template()
+	}
+
+	if p.match(tokens.IF) {
+		return p.ifExpression()
+	}
+
 	if p.match(tokens.CHAR) {
 		char, err := strconv.Unquote(`"` + p.previous().Lexeme + `"`)
 		if err != nil {
@@ -263,7 +474,7 @@ func (p *Parser) primary() Expression {
 		}
 	}
 
-	if p.match(tokens.IDENTIFIER) {
+	if p.match(tokens.IDENTIFIER, tokens.THIS) {
 		return VariableExpression{
 			Name: p.previous(),
 		}
@@ -281,6 +492,10 @@ func (p *Parser) primary() Expression {
 		return p.inlineFunction()
 	}
 
+	if p.match(tokens.MATCH) {
+		return p.matchExpression()
+	}
+
 	if p.match(tokens.LEFT_PARENTHESIS) {
 		expr := p.expression()
 		p.consume("Expect ')' after expression.", tokens.RIGHT_PARENTHESIS)
@@ -318,7 +533,15 @@ func (p *Parser) finishCall(expr Expression) Expression {
 				break
 			}
 
-			arguments = append(arguments, p.expression())
+			argument := p.argument()
+
+			if _, named := argument.(NamedArgument); !named && len(arguments) > 0 {
+				if _, previousNamed := arguments[len(arguments)-1].(NamedArgument); previousNamed {
+					p.throw("Positional arguments cannot follow named arguments.")
+				}
+			}
+
+			arguments = append(arguments, argument)
 
 			if !p.match(tokens.COMMA) {
 				break
@@ -336,12 +559,24 @@ func (p *Parser) finishCall(expr Expression) Expression {
 
 func (p *Parser) call() Expression {
 	expr := p.primary()
+	optional := false
 
 	for {
 		if p.match(tokens.LEFT_PARENTHESIS) {
-			return p.finishCall(expr)
-		} else if p.match(tokens.DOT, tokens.LEFT_BRACKET) {
-			if p.previous().Type == tokens.DOT {
+			expr = p.finishCall(expr)
+		} else if p.check(tokens.HOOK_DOT) && p.checkNext(tokens.LEFT_PARENTHESIS) {
+			p.advance()
+			p.advance()
+			call := p.finishCall(expr).(CallExpression)
+			call.Optional = true
+			expr = call
+			optional = true
+		} else if p.match(tokens.DOT, tokens.LEFT_BRACKET, tokens.HOOK_DOT, tokens.HOOK_BRACKET) {
+			link := p.previous().Type
+			isOptional := link == tokens.HOOK_DOT || link == tokens.HOOK_BRACKET
+			optional = optional || isOptional
+
+			if link == tokens.DOT || link == tokens.HOOK_DOT {
 				property := p.consume("Expect property name after '.'.", tokens.IDENTIFIER)
 				expr = MemberExpression{
 					Object: expr,
@@ -350,14 +585,35 @@ func (p *Parser) call() Expression {
 					},
 					Computed: false,
 					Type:     DotMember,
+					Optional: isOptional,
 				}
 			} else {
-				property := p.expression()
-				expr = MemberExpression{
-					Object:   expr,
-					Property: property,
-					Computed: true,
-					Type:     BracketMember,
+				var property Expression
+
+				if !p.check(tokens.COLON) {
+					property = p.expression()
+				}
+
+				if p.match(tokens.COLON) {
+					slice := SliceExpression{
+						Object:   expr,
+						Start:    property,
+						Optional: isOptional,
+					}
+
+					if !p.check(tokens.RIGHT_BRACKET) {
+						slice.End = p.expression()
+					}
+
+					expr = slice
+				} else {
+					expr = MemberExpression{
+						Object:   expr,
+						Property: property,
+						Computed: true,
+						Type:     BracketMember,
+						Optional: isOptional,
+					}
 				}
 				p.consume("Expect ']' after expression.", tokens.RIGHT_BRACKET)
 			}
@@ -368,12 +624,29 @@ func (p *Parser) call() Expression {
 				Namespace: expr,
 				Property:  property,
 			}
+		} else if p.match(tokens.HOOK) {
+			expr = PropagateExpression{
+				Expression: expr,
+				Operator:   p.previous(),
+			}
 
+		} else if p.check(tokens.NOT) && p.peek().Loc.Line == p.previous().Loc.Line && p.peek().Lexeme == "!" {
+			// a postfix '!' must stay on the line of its operand to not swallow a negation
+			expr = AssertExpression{
+				Expression: expr,
+				Operator:   p.advance(),
+			}
 		} else {
 			break
 		}
 	}
 
+	if optional {
+		return OptionalChainExpression{
+			Expression: expr,
+		}
+	}
+
 	return expr
 }
 
@@ -387,14 +660,28 @@ func (p *Parser) unary() Expression {
 		}
 	}
 
-	return p.call()
+	return p.power()
+}
+
+func (p *Parser) power() Expression {
+	expr := p.call()
+
+	if p.match(tokens.STAR_STAR) {
+		return BinaryExpression{
+			Left:     expr,
+			Operator: p.previous(),
+			Right:    p.unary(),
+		}
+	}
+
+	return expr
 }
 
 func (p *Parser) is() Expression {
 	expr := p.unary()
 
 	for p.match(tokens.IS) {
-		paramType := p.consume("Expect is operator type.", tokens.DATA_TYPES...)
+		paramType := p.singleTypeAnnotation("Expect is operator type.", tokens.DATA_TYPES...)
 
 		expr = IsExpression{
 			Expr:     expr,
@@ -408,7 +695,7 @@ func (p *Parser) is() Expression {
 func (p *Parser) multiplication() Expression {
 	expr := p.is()
 
-	for p.match(tokens.SLASH, tokens.STAR, tokens.PERCENT, tokens.ENUMOF) {
+	for p.match(tokens.SLASH, tokens.SLASH_SLASH, tokens.STAR, tokens.PERCENT, tokens.ENUMOF) {
 		expr = BinaryExpression{
 			Left:     expr,
 			Operator: p.previous(),
@@ -433,10 +720,10 @@ func (p *Parser) addition() Expression {
 	return expr
 }
 
-func (p *Parser) comparison() Expression {
+func (p *Parser) shift() Expression {
 	expr := p.addition()
 
-	for p.match(tokens.GREATER_THAN, tokens.GREATER_THAN_EQUAL, tokens.LESS_THAN, tokens.LESS_THAN_EQUAL) {
+	for p.match(tokens.LESS_LESS, tokens.GREATER_GREATER) {
 		expr = BinaryExpression{
 			Left:     expr,
 			Operator: p.previous(),
@@ -447,6 +734,83 @@ func (p *Parser) comparison() Expression {
 	return expr
 }
 
+func (p *Parser) bitwiseAnd() Expression {
+	expr := p.shift()
+
+	for p.match(tokens.AMPERSAND) {
+		expr = BinaryExpression{
+			Left:     expr,
+			Operator: p.previous(),
+			Right:    p.shift(),
+		}
+	}
+
+	return expr
+}
+
+func (p *Parser) bitwiseXor() Expression {
+	expr := p.bitwiseAnd()
+
+	for p.match(tokens.CARET) {
+		expr = BinaryExpression{
+			Left:     expr,
+			Operator: p.previous(),
+			Right:    p.bitwiseAnd(),
+		}
+	}
+
+	return expr
+}
+
+// a leading '|' opens an inline function, only an infix one is a bitwise or
+func (p *Parser) bitwiseOr() Expression {
+	expr := p.bitwiseXor()
+
+	for p.match(tokens.PIPE) {
+		expr = BinaryExpression{
+			Left:     expr,
+			Operator: p.previous(),
+			Right:    p.bitwiseXor(),
+		}
+	}
+
+	return expr
+}
+
+func (p *Parser) rangeExpression() Expression {
+	expr := p.bitwiseOr()
+
+	if p.match(tokens.DOT_DOT, tokens.DOT_DOT_EQUAL) {
+		rangeExpr := RangeExpression{
+			Start:    expr,
+			Operator: p.previous(),
+			Stop:     p.bitwiseOr(),
+		}
+
+		if p.match(tokens.STEP) {
+			rangeExpr.Step = p.bitwiseOr()
+		}
+
+		return rangeExpr
+	}
+
+	return expr
+}
+
+func (p *Parser) comparison() Expression {
+	expr := p.rangeExpression()
+
+	for p.match(tokens.GREATER_THAN, tokens.GREATER_THAN_EQUAL, tokens.LESS_THAN, tokens.LESS_THAN_EQUAL, tokens.IN) {
+		expr = BinaryExpression{
+			Left:     expr,
+			Operator: p.previous(),
+			Right:    p.rangeExpression(),
+		}
+	}
+
+	return expr
+}
+
 func (p *Parser) equality() Expression {
 	expr := p.comparison()
 
@@ -489,9 +853,23 @@ func (p *Parser) or() Expression {
 	return expr
 }
 
-func (p *Parser) expression() Expression {
+func (p *Parser) coalesce() Expression {
 	expr := p.or()
 
+	for p.match(tokens.HOOK_HOOK) {
+		expr = LogicalExpression{
+			Left:     expr,
+			Operator: p.previous(),
+			Right:    p.or(),
+		}
+	}
+
+	return expr
+}
+
+func (p *Parser) expression() Expression {
+	expr := p.coalesce()
+
 	if p.match(tokens.EQUAL, tokens.PLUS_EQUAL, tokens.MINUS_EQUAL) {
 		operator := p.previous()
 		value := p.expression()
@@ -530,45 +908,22 @@ func (p *Parser) expression() Expression {
 }
 
 func (p *Parser) inlineFunction() FunctionExpression {
-	var params []Parameter
-
-	if !p.check(tokens.PIPE) {
-		for {
-			paramName := p.consume("Expect parameter name.", tokens.IDENTIFIER)
-			variadic := p.match(tokens.VARIADIC)
-			paramType := p.consume("Expect parameter type.", tokens.DATA_TYPES...)
-			parsedParamType, err := types.ParseTokenType(paramType.Type)
-
-			if err != nil {
-				p.throw("Invalid parameter type.")
-			}
-
-			nullable := p.match(tokens.HOOK)
-			params = append(params, Parameter{
-				Name:     paramName,
-				Type:     parsedParamType,
-				Variadic: variadic,
-				Nullable: nullable,
-			})
-
-			if !p.match(tokens.COMMA) {
-				break
-			}
-		}
-	}
+	params := p.parameters(tokens.PIPE)
 
 	p.consume("Expect '|' after parameters.", tokens.PIPE)
 
-	var returnType tokens.Token
+	var returnType TypeAnnotation
 
 	if !p.check(tokens.LEFT_BRACE) {
-		returnType = p.consume("Expect return type.", append([]tokens.TokenType{tokens.VOID_TYPE}, tokens.DATA_TYPES...)...)
+		returnType = p.typeAnnotation("Expect return type.", append([]tokens.TokenType{tokens.VOID_TYPE}, tokens.DATA_TYPES...)...)
 	} else {
 		currentToken := p.peek()
-		returnType = tokens.Token{
-			Type:   tokens.VOID_TYPE,
-			Loc:    currentToken.Loc,
-			Lexeme: currentToken.Lexeme,
+		returnType = TypeAnnotation{
+			Token: tokens.Token{
+				Type:   tokens.VOID_TYPE,
+				Loc:    currentToken.Loc,
+				Lexeme: "void",
+			},
 		}
 	}
 
@@ -586,14 +941,12 @@ func (p *Parser) inlineFunction() FunctionExpression {
 func (p *Parser) varDeclaration() Statement {
 	isMutable := p.previous().Type == tokens.MUT
 	name := p.consume("Expect variable name.", tokens.IDENTIFIER)
-	variableType := p.consume("Expect variable type.", tokens.DATA_TYPES...)
-	nullable := p.match(tokens.HOOK)
+	variableType := p.variableType()
 
 	declaration := VarStatement{
-		Name:     name,
-		Mutable:  isMutable,
-		Type:     variableType,
-		Nullable: nullable,
+		Name:    name,
+		Mutable: isMutable,
+		Type:    variableType,
 	}
 
 	if p.match(tokens.COMMA) {
@@ -603,14 +956,12 @@ func (p *Parser) varDeclaration() Statement {
 		if !p.check(tokens.EQUAL) {
 			for {
 				name := p.consume("Expect variable name.", tokens.IDENTIFIER)
-				variableType := p.consume("Expect variable type.", tokens.DATA_TYPES...)
-				nullable := p.match(tokens.HOOK)
+				variableType := p.variableType()
 
 				declarations = append(declarations, VarStatement{
-					Name:     name,
-					Mutable:  isMutable,
-					Type:     variableType,
-					Nullable: nullable,
+					Name:    name,
+					Mutable: isMutable,
+					Type:    variableType,
 				})
 
 				if !p.match(tokens.COMMA) {
@@ -634,9 +985,22 @@ func (p *Parser) varDeclaration() Statement {
 	return declaration
 }
 
+// the type is inferred when the name is directly followed by the initializer
+func (p *Parser) variableType() TypeAnnotation {
+	if p.check(tokens.EQUAL) || p.check(tokens.COMMA) {
+		return TypeAnnotation{}
+	}
+
+	return p.typeAnnotation("Expect variable type.", tokens.DATA_TYPES...)
+}
+
 func (p *Parser) returnStatement() Statement {
 	keyword := p.previous()
-	value := p.expression()
+
+	var value Expression
+	if !p.check(tokens.RIGHT_BRACE) && !p.isAtEOF() && p.peek().Loc.Line == keyword.Loc.Line {
+		value = p.expression()
+	}
 
 	return ReturnStatement{
 		Keyword: keyword,
@@ -644,6 +1008,45 @@ func (p *Parser) returnStatement() Statement {
 	}
 }
 
+func (p *Parser) throwStatement() Statement {
+	keyword := p.previous()
+	value := p.expression()
+
+	return ThrowStatement{
+		Keyword: keyword,
+		Value:   value,
+	}
+}
+
+func (p *Parser) tryStatement() Statement {
+	statement := TryStatement{
+		Keyword: p.previous(),
+	}
+
+	p.consume("Expect '{' after try.", tokens.LEFT_BRACE)
+	statement.Body, _ = p.block()
+
+	if p.match(tokens.CATCH) {
+		if p.match(tokens.IDENTIFIER) {
+			statement.CatchName = p.previous()
+		}
+
+		p.consume("Expect '{' after catch.", tokens.LEFT_BRACE)
+		statement.CatchBody, _ = p.block()
+	}
+
+	if p.match(tokens.FINALLY) {
+		p.consume("Expect '{' after finally.", tokens.LEFT_BRACE)
+		statement.FinallyBody, _ = p.block()
+	}
+
+	if statement.CatchBody == nil && statement.FinallyBody == nil {
+		p.throw("Expect catch or finally after try block.")
+	}
+
+	return statement
+}
+
 func (p *Parser) publicStatement() Statement {
 	keyword := p.previous()
 	var identifiers []tokens.Token
@@ -682,19 +1085,33 @@ func (p *Parser) enumStatement() Statement {
 	p.consume("Expect '{' before enum body.", tokens.LEFT_BRACE)
 
 	members := make(map[string]EnumMember)
+	var order []string
+	argumentTypes := make(map[string][]TypeAnnotation)
+	backingValues := make(map[string]Expression)
 
 	for !p.check(tokens.RIGHT_BRACE) && !p.isAtEOF() {
 		memberName := p.consume("Expect enum member name.", tokens.IDENTIFIER)
 		var args []EnumArgument
 
+		if _, exists := members[memberName.Lexeme]; exists {
+			p.throw("Duplicated enum member.")
+		}
+
 		if p.match(tokens.LEFT_PARENTHESIS) {
 			if !p.check(tokens.RIGHT_PARENTHESIS) {
 				for {
-					paramType := p.consume("Expect enum argument type.", tokens.DATA_TYPES...)
-					parsedParamType, err := types.ParseTokenType(paramType.Type)
-
-					if err != nil {
-						p.throw("Invalid enum argument type.")
+					paramType := p.typeAnnotation("Expect enum argument type.", tokens.DATA_TYPES...)
+					argumentTypes[memberName.Lexeme] = append(argumentTypes[memberName.Lexeme], paramType)
+
+					// enums and structs are only known once the enum is declared
+					var parsedParamType types.UmbraType
+					if paramType.Token.Type != tokens.IDENTIFIER {
+						parsed, err := types.ParseTokenType(paramType.Token.Type)
+						if err != nil {
+							p.throw("Invalid enum argument type.")
+						}
+
+						parsedParamType = parsed
 					}
 
 					args = append(args, EnumArgument{
@@ -710,10 +1127,20 @@ func (p *Parser) enumStatement() Statement {
 			}
 		}
 
+		if p.match(tokens.EQUAL) {
+			if len(args) > 0 {
+				p.throw("Enum members with arguments cannot have a backing value.")
+			}
+
+			backingValues[memberName.Lexeme] = p.expression()
+		}
+
 		members[memberName.Lexeme] = EnumMember{
 			Name:      memberName.Lexeme,
 			Arguments: args,
+			Ordinal:   len(order),
 		}
+		order = append(order, memberName.Lexeme)
 
 		if p.match(tokens.RIGHT_BRACE) {
 			break
@@ -721,12 +1148,107 @@ func (p *Parser) enumStatement() Statement {
 	}
 
 	return EnumStatement{
+		Name:          name,
+		Members:       members,
+		Order:         order,
+		ArgumentTypes: argumentTypes,
+		BackingValues: backingValues,
+	}
+}
+
+func (p *Parser) interfaceStatement() Statement {
+	name := p.consume("Expect interface name.", tokens.IDENTIFIER)
+
+	p.consume("Expect '{' before interface body.", tokens.LEFT_BRACE)
+
+	var methods []FunctionExpression
+
+	for !p.check(tokens.RIGHT_BRACE) && !p.isAtEOF() {
+		methodName := p.consume("Expect interface method name.", tokens.IDENTIFIER)
+
+		for _, method := range methods {
+			if method.Name.Lexeme == methodName.Lexeme {
+				p.throw("Duplicated interface method.")
+			}
+		}
+
+		p.consume("Expect '(' after method name.", tokens.LEFT_PARENTHESIS)
+		params := p.parameters(tokens.RIGHT_PARENTHESIS)
+		closing := p.consume("Expect ')' after parameters.", tokens.RIGHT_PARENTHESIS)
+
+		methods = append(methods, FunctionExpression{
+			Name:       methodName,
+			Params:     params,
+			ReturnType: p.signatureReturnType(closing),
+		})
+	}
+
+	p.consume("Expect '}' after interface body.", tokens.RIGHT_BRACE)
+
+	return InterfaceStatement{
 		Name:    name,
-		Members: members,
+		Methods: methods,
+	}
+}
+
+func (p *Parser) implStatement() Statement {
+	keyword := p.previous()
+	iface := p.consume("Expect interface name.", tokens.IDENTIFIER)
+
+	p.consume("Expect 'for' after interface name.", tokens.FOR)
+
+	return ImplStatement{
+		Keyword:   keyword,
+		Interface: iface,
+		Target:    p.consume("Expect struct or enum name.", tokens.IDENTIFIER),
+	}
+}
+
+func (p *Parser) typeAliasStatement() Statement {
+	name := p.consume("Expect type alias name.", tokens.IDENTIFIER)
+
+	p.consume("Expect '=' after type alias name.", tokens.EQUAL)
+
+	return TypeAliasStatement{
+		Name: name,
+		Type: p.typeAnnotation("Expect aliased type.", tokens.DATA_TYPES...),
 	}
 }
 
-func (p *Parser) matchStatement() Statement {
+func (p *Parser) structStatement() Statement {
+	name := p.consume("Expect struct name.", tokens.IDENTIFIER)
+
+	p.consume("Expect '{' before struct body.", tokens.LEFT_BRACE)
+
+	var fields []StructField
+
+	for !p.check(tokens.RIGHT_BRACE) && !p.isAtEOF() {
+		fieldName := p.consume("Expect struct field name.", tokens.IDENTIFIER)
+		fieldType := p.typeAnnotation("Expect struct field type.", tokens.DATA_TYPES...)
+
+		for _, field := range fields {
+			if field.Name.Lexeme == fieldName.Lexeme {
+				p.throw("Duplicated struct field.")
+			}
+		}
+
+		fields = append(fields, StructField{
+			Name: fieldName,
+			Type: fieldType,
+		})
+
+		p.match(tokens.COMMA)
+	}
+
+	p.consume("Expect '}' after struct body.", tokens.RIGHT_BRACE)
+
+	return StructStatement{
+		Name:   name,
+		Fields: fields,
+	}
+}
+
+func (p *Parser) matchExpression() Expression {
 	expr := p.expression()
 
 	p.consume("Expect '{' before match body.", tokens.LEFT_BRACE)
@@ -734,26 +1256,55 @@ func (p *Parser) matchStatement() Statement {
 	var cases []MatchCase
 
 	for !p.check(tokens.RIGHT_BRACE) && !p.isAtEOF() {
-		caseExpr := p.expression()
+		matchCase := MatchCase{
+			Expression: p.bitwiseXor(),
+		}
 
-		p.consume("Expect callback after case matching", tokens.PIPE)
+		if p.match(tokens.PIPE) {
+			matchCase.Callback = p.inlineFunction()
+		} else {
+			if p.match(tokens.IF) {
+				matchCase.Guard = p.expression()
+			}
 
-		callback := p.inlineFunction()
+			p.consume("Expect '{' before match arm body.", tokens.LEFT_BRACE)
+			matchCase.Body, _ = p.block()
+		}
 
-		cases = append(cases, MatchCase{
-			Expression: caseExpr,
-			Callback:   callback,
-		})
+		cases = append(cases, matchCase)
 	}
 
 	p.consume("Expect '}' after match body", tokens.RIGHT_BRACE)
 
-	return MatchStatement{
+	return MatchExpression{
 		Expression: expr,
 		Cases:      cases,
 	}
 }
 
+func (p *Parser) ifExpression() Expression {
+	expr := IfExpression{
+		Keyword:   p.previous(),
+		Condition: p.expression(),
+	}
+
+	p.consume("Expect '{' after if condition.", tokens.LEFT_BRACE)
+	expr.ThenBranch, _ = p.block()
+
+	if p.match(tokens.ELSE) {
+		if p.match(tokens.IF) {
+			expr.ElseBranch = ExpressionStatement{
+				Expression: p.ifExpression(),
+			}
+		} else {
+			p.consume("Expect '{' after else.", tokens.LEFT_BRACE)
+			expr.ElseBranch, _ = p.block()
+		}
+	}
+
+	return expr
+}
+
 func (p *Parser) printStatement(channel PrintChannel) Statement {
 	value := p.expression()
 	return PrintStatement{
@@ -828,11 +1379,36 @@ func (p *Parser) conditionalForStatement() Statement {
 	}
 }
 
+func (p *Parser) iterableForStatement() Statement {
+	key := p.consume("Expect identifier after 'for'.", tokens.IDENTIFIER)
+	var value tokens.Token
+
+	if p.match(tokens.COMMA) {
+		value = p.consume("Expect identifier after ','.", tokens.IDENTIFIER)
+	}
+
+	p.consume("Expect 'in' after loop variables.", tokens.IN)
+
+	iterable := p.expression()
+	body := p.statement()
+
+	return IterableForStatement{
+		Key:      key,
+		Value:    value,
+		Iterable: iterable,
+		Body:     body,
+	}
+}
+
 func (p *Parser) forStatement() Statement {
 	if p.match(tokens.CONST, tokens.MUT) {
 		return p.initializedForStatement()
 	}
 
+	if p.check(tokens.IDENTIFIER) && p.checkNext(tokens.IN, tokens.COMMA) {
+		return p.iterableForStatement()
+	}
+
 	return p.conditionalForStatement()
 }
 
@@ -860,6 +1436,12 @@ func (p *Parser) statement() Statement {
 	if p.match(tokens.RETURN) {
 		return p.returnStatement()
 	}
+	if p.match(tokens.THROW) {
+		return p.throwStatement()
+	}
+	if p.match(tokens.TRY) {
+		return p.tryStatement()
+	}
 	if p.match(tokens.BREAK) {
 		return BreakStatement{
 			Loc: p.previous().Loc,
@@ -879,8 +1461,17 @@ func (p *Parser) statement() Statement {
 	if p.match(tokens.ENUM) {
 		return p.enumStatement()
 	}
-	if p.match(tokens.MATCH) {
-		return p.matchStatement()
+	if p.match(tokens.STRUCT) {
+		return p.structStatement()
+	}
+	if p.match(tokens.TYPE) {
+		return p.typeAliasStatement()
+	}
+	if p.match(tokens.INTERFACE) {
+		return p.interfaceStatement()
+	}
+	if p.match(tokens.IMPL) {
+		return p.implStatement()
 	}
 	if p.match(tokens.LEFT_BRACE) {
 		blockStatement, _ := p.block()
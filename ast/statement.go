@@ -1,6 +1,9 @@
 package ast
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pmqueiroz/umbra/globals"
 	"github.com/pmqueiroz/umbra/tokens"
 	"github.com/pmqueiroz/umbra/types"
@@ -43,37 +46,66 @@ func (s ExpressionStatement) GetLocs() []globals.Loc {
 	return s.Expression.GetLocs()
 }
 
-type Parameter struct {
-	Name     tokens.Token
-	Type     types.UmbraType
-	Variadic bool
-	Nullable bool
+type TypeAnnotation struct {
+	Token     tokens.Token
+	Arguments []TypeAnnotation
+	Return    *TypeAnnotation
+	Members   []TypeAnnotation
+	Nullable  bool
 }
 
-type MatchCaseParameter struct {
-	Name tokens.Token
-}
+func (t TypeAnnotation) Reference() string {
+	if len(t.Members) > 0 {
+		members := []string{}
+		for _, member := range t.Members {
+			members = append(members, member.Reference())
+		}
 
-type MatchCase struct {
-	Expression Expression
-	Callback   FunctionExpression
-}
+		return strings.Join(members, " | ")
+	}
 
-type MatchStatement struct {
-	Expression Expression
-	Cases      []MatchCase
-}
+	reference := t.Token.Lexeme
 
-func (s MatchStatement) Reference() string {
-	return "match " + s.Expression.Reference() + " { ... }"
+	if t.Return != nil {
+		params := []string{}
+		for _, argument := range t.Arguments {
+			params = append(params, argument.Reference())
+		}
+
+		reference += "(" + strings.Join(params, ", ") + ") " + t.Return.Reference()
+	} else if len(t.Arguments) > 0 {
+		arguments := ""
+		for i, argument := range t.Arguments {
+			arguments += argument.Reference()
+			if i < len(t.Arguments)-1 {
+				arguments += ", "
+			}
+		}
+
+		reference += "<" + arguments + ">"
+	}
+
+	if t.Nullable {
+		reference += "?"
+	}
+
+	return reference
 }
 
-func (s MatchStatement) GetLocs() []globals.Loc {
-	locs := []globals.Loc{}
+// an annotation without a token is left out by the user and inferred from the value
+func (t TypeAnnotation) Inferred() bool {
+	return t.Token.Type == ""
+}
 
-	locs = append(locs, s.Expression.GetLocs()...)
+func (t TypeAnnotation) GetLocs() []globals.Loc {
+	return []globals.Loc{t.Token.Loc}
+}
 
-	return locs
+type Parameter struct {
+	Name     tokens.Token
+	Type     TypeAnnotation
+	Variadic bool
+	Default  Expression
 }
 
 type IfStatement struct {
@@ -126,17 +158,66 @@ type ReturnStatement struct {
 }
 
 func (s ReturnStatement) Reference() string {
+	if s.Value == nil {
+		return "return"
+	}
+
 	return "return " + s.Value.Reference()
 }
 
 func (s ReturnStatement) GetLocs() []globals.Loc {
 	locs := []globals.Loc{s.Keyword.Loc}
 
+	if s.Value != nil {
+		locs = append(locs, s.Value.GetLocs()...)
+	}
+
+	return locs
+}
+
+type ThrowStatement struct {
+	Keyword tokens.Token
+	Value   Expression
+}
+
+func (s ThrowStatement) Reference() string {
+	return "throw " + s.Value.Reference()
+}
+
+func (s ThrowStatement) GetLocs() []globals.Loc {
+	locs := []globals.Loc{s.Keyword.Loc}
+
 	locs = append(locs, s.Value.GetLocs()...)
 
 	return locs
 }
 
+type TryStatement struct {
+	Keyword     tokens.Token
+	Body        Statement
+	CatchName   tokens.Token
+	CatchBody   Statement
+	FinallyBody Statement
+}
+
+func (s TryStatement) Reference() string {
+	reference := "try { ... }"
+
+	if s.CatchBody != nil {
+		reference += " catch " + s.CatchName.Lexeme + " { ... }"
+	}
+
+	if s.FinallyBody != nil {
+		reference += " finally { ... }"
+	}
+
+	return reference
+}
+
+func (s TryStatement) GetLocs() []globals.Loc {
+	return []globals.Loc{s.Keyword.Loc}
+}
+
 type BreakStatement struct {
 	Loc globals.Loc
 }
@@ -202,13 +283,30 @@ type EnumArgument struct {
 type EnumMember struct {
 	Name      string
 	Arguments []EnumArgument
-	Signature string
+	Parent    globals.Node
+	Ordinal   int
+	Value     interface{}
+}
+
+func (m EnumMember) String() string {
+	if len(m.Arguments) == 0 {
+		return m.Name
+	}
+
+	arguments := []string{}
+	for _, argument := range m.Arguments {
+		arguments = append(arguments, fmt.Sprint(argument.Value))
+	}
+
+	return m.Name + "(" + strings.Join(arguments, ", ") + ")"
 }
 
 type EnumStatement struct {
-	Name      tokens.Token
-	Members   map[string]EnumMember
-	Signature string
+	Name          tokens.Token
+	Members       map[string]EnumMember
+	Order         []string
+	ArgumentTypes map[string][]TypeAnnotation
+	BackingValues map[string]Expression
 }
 
 func (s EnumStatement) Reference() string {
@@ -225,16 +323,88 @@ func (e *EnumStatement) Get(name tokens.Token) (EnumMember, bool) {
 	return member, ok
 }
 
-func (e *EnumStatement) ValidMember(member EnumMember) bool {
-	return e.Signature == member.Signature
+func (e *EnumStatement) OrderedMembers() []EnumMember {
+	members := []EnumMember{}
+	for _, name := range e.Order {
+		members = append(members, e.Members[name])
+	}
+
+	return members
+}
+
+type TypeAliasStatement struct {
+	Name tokens.Token
+	Type TypeAnnotation
+}
+
+func (s TypeAliasStatement) Reference() string {
+	return "type " + s.Name.Lexeme + " = " + s.Type.Reference()
+}
+
+func (s TypeAliasStatement) GetLocs() []globals.Loc {
+	return []globals.Loc{s.Name.Loc}
+}
+
+type InterfaceStatement struct {
+	Name    tokens.Token
+	Methods []FunctionExpression
+}
+
+func (s InterfaceStatement) Reference() string {
+	return "interface " + s.Name.Lexeme + " { ... }"
+}
+
+func (s InterfaceStatement) GetLocs() []globals.Loc {
+	return []globals.Loc{s.Name.Loc}
+}
+
+type ImplStatement struct {
+	Keyword   tokens.Token
+	Interface tokens.Token
+	Target    tokens.Token
+}
+
+func (s ImplStatement) Reference() string {
+	return "impl " + s.Interface.Lexeme + " for " + s.Target.Lexeme
+}
+
+func (s ImplStatement) GetLocs() []globals.Loc {
+	return []globals.Loc{s.Keyword.Loc, s.Interface.Loc, s.Target.Loc}
+}
+
+type StructField struct {
+	Name tokens.Token
+	Type TypeAnnotation
+}
+
+type StructStatement struct {
+	Name   tokens.Token
+	Fields []StructField
+}
+
+func (s StructStatement) Reference() string {
+	return "struct " + s.Name.Lexeme + " { ... }"
+}
+
+func (s StructStatement) GetLocs() []globals.Loc {
+	return []globals.Loc{s.Name.Loc}
+}
+
+func (s *StructStatement) Get(name string) (StructField, bool) {
+	for _, field := range s.Fields {
+		if field.Name.Lexeme == name {
+			return field, true
+		}
+	}
+
+	return StructField{}, false
 }
 
 type VarStatement struct {
 	Name        tokens.Token
 	Initializer Expression
 	Mutable     bool
-	Type        tokens.Token
-	Nullable    bool
+	Type        TypeAnnotation
 }
 
 func (s VarStatement) Reference() string {
@@ -249,7 +419,11 @@ func (s VarStatement) Reference() string {
 		initializer = " = " + s.Initializer.Reference()
 	}
 
-	return varInit + " " + s.Name.Lexeme + " " + s.Type.Lexeme + initializer
+	if s.Type.Inferred() {
+		return varInit + " " + s.Name.Lexeme + initializer
+	}
+
+	return varInit + " " + s.Name.Lexeme + " " + s.Type.Reference() + initializer
 }
 
 func (s VarStatement) GetLocs() []globals.Loc {
@@ -258,7 +432,9 @@ func (s VarStatement) GetLocs() []globals.Loc {
 	if s.Initializer != nil {
 		locs = append(locs, s.Initializer.GetLocs()...)
 	}
-	locs = append(locs, s.Type.Loc)
+	if !s.Type.Inferred() {
+		locs = append(locs, s.Type.GetLocs()...)
+	}
 
 	return locs
 }
@@ -330,6 +506,30 @@ func (s ConditionalForStatement) GetLocs() []globals.Loc {
 	return locs
 }
 
+type IterableForStatement struct {
+	Key      tokens.Token
+	Value    tokens.Token
+	Iterable Expression
+	Body     Statement
+}
+
+func (s IterableForStatement) Reference() string {
+	names := s.Key.Lexeme
+	if s.Value.Lexeme != "" {
+		names += ", " + s.Value.Lexeme
+	}
+
+	return "for " + names + " in " + s.Iterable.Reference() + " { ... }"
+}
+
+func (s IterableForStatement) GetLocs() []globals.Loc {
+	locs := []globals.Loc{s.Key.Loc}
+
+	locs = append(locs, s.Iterable.GetLocs()...)
+
+	return locs
+}
+
 type ModuleStatement struct {
 	Declarations []Statement
 }
@@ -12,10 +12,9 @@ func header() {
 	fmt.Print("Welcome to Umbra REPL!\nEnter :q to exit.\n")
 }
 
-func Repl(evaluate func(content string, env *environment.Environment)) {
+func Repl(env *environment.Environment, evaluate func(content string, env *environment.Environment)) {
 	header()
 	reader := bufio.NewReader(os.Stdin)
-	env := environment.NewEnvironment(nil)
 
 	for {
 		fmt.Print("> ")
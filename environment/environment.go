@@ -11,8 +11,7 @@ import (
 
 type Variable struct {
 	Data     interface{}
-	DataType types.UmbraType
-	Nullable bool
+	DataType types.RuntimeType
 	Mutable  bool
 	private  bool
 	native   bool
@@ -25,6 +24,7 @@ type Namespace struct {
 type Environment struct {
 	values     map[string]Variable
 	namespaces map[string]Namespace
+	narrowed   map[string]types.RuntimeType
 	parent     *Environment
 }
 
@@ -32,11 +32,20 @@ func NewEnvironment(parent *Environment) *Environment {
 	return &Environment{
 		values:     make(map[string]Variable),
 		namespaces: make(map[string]Namespace),
+		narrowed:   make(map[string]types.RuntimeType),
 		parent:     parent,
 	}
 }
 
 func (env *Environment) Get(name string, allowPrivate bool) (Variable, bool) {
+	return env.get(name, allowPrivate, true)
+}
+
+func (env *Environment) GetDeclared(name string, allowPrivate bool) (Variable, bool) {
+	return env.get(name, allowPrivate, false)
+}
+
+func (env *Environment) get(name string, allowPrivate bool, narrowed bool) (Variable, bool) {
 	value, exists := env.values[name]
 	if exists {
 		if value.private && !allowPrivate {
@@ -46,14 +55,23 @@ func (env *Environment) Get(name string, allowPrivate bool) (Variable, bool) {
 	}
 
 	if env.parent != nil {
-		return env.parent.Get(name, allowPrivate)
+		variable, ok := env.parent.get(name, allowPrivate, narrowed)
+		if narrowedType, isNarrowed := env.narrowed[name]; ok && isNarrowed && narrowed {
+			variable.DataType = narrowedType
+		}
+		return variable, ok
 	}
 	return Variable{}, false
 }
 
+func (env *Environment) Narrow(name string, dataType types.RuntimeType) {
+	env.narrowed[name] = dataType
+}
+
 func (env *Environment) Set(name string, value interface{}) bool {
 	if val, exists := env.values[name]; exists {
-		env.values[name] = Variable{Data: value, DataType: val.DataType, private: val.private, Nullable: val.Nullable, Mutable: val.Mutable}
+		val.Data = value
+		env.values[name] = val
 		return true
 	}
 	if env.parent != nil {
@@ -62,16 +80,31 @@ func (env *Environment) Set(name string, value interface{}) bool {
 	return false
 }
 
-func (env *Environment) Create(node globals.Node, name string, value interface{}, dataType types.UmbraType, nullable bool, internal bool, mutable bool) bool {
-	if _, exists := env.Get(name, true); exists {
+// internal declarations from outer scopes can be shadowed by user code
+func (env *Environment) Create(node globals.Node, name string, value interface{}, dataType types.RuntimeType, internal bool, mutable bool) bool {
+	if variable, exists := env.Get(name, true); exists && (!variable.native || env.declares(name)) {
 		fmt.Println(exception.NewUmbraError("RT001", node, name))
 		os.Exit(1)
 		return false
 	}
-	env.values[name] = Variable{Data: value, DataType: dataType, private: true, Nullable: nullable, native: internal, Mutable: mutable}
+	env.values[name] = Variable{Data: value, DataType: dataType, private: true, native: internal, Mutable: mutable}
 	return true
 }
 
+func (env *Environment) Bind(name string, value interface{}, dataType types.RuntimeType) bool {
+	if _, exists := env.values[name]; exists {
+		return false
+	}
+
+	env.values[name] = Variable{Data: value, DataType: dataType, private: true}
+	return true
+}
+
+func (env *Environment) declares(name string) bool {
+	_, exists := env.values[name]
+	return exists
+}
+
 func (env *Environment) ListValues(includePrivate bool) map[string]interface{} {
 	allValues := make(map[string]interface{})
 	for key, value := range env.values {
@@ -0,0 +1,66 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/pmqueiroz/umbra/types"
+)
+
+func TestNarrowOnlyAffectsReads(t *testing.T) {
+	declared := types.RuntimeType{Type: types.NUM, Nullable: true}
+	narrowed := types.RuntimeType{Type: types.NUM}
+
+	env := NewEnvironment(nil)
+	env.Create(nil, "x", 1.0, declared, false, true)
+
+	branch := NewEnvironment(env)
+	branch.Narrow("x", narrowed)
+
+	if variable, _ := branch.Get("x", true); variable.DataType.Nullable {
+		t.Errorf("got %v, want the narrowed type", variable.DataType)
+	}
+
+	if variable, _ := branch.GetDeclared("x", true); !variable.DataType.Nullable {
+		t.Errorf("got %v, want the declared type", variable.DataType)
+	}
+
+	if variable, _ := env.Get("x", true); !variable.DataType.Nullable {
+		t.Errorf("got %v, narrowing leaked to the outer scope", variable.DataType)
+	}
+}
+
+func TestBindShadowsOuterScopes(t *testing.T) {
+	env := NewEnvironment(nil)
+	env.Create(nil, "k", 1.0, types.RuntimeType{Type: types.NUM}, false, false)
+
+	arm := NewEnvironment(env)
+	if !arm.Bind("k", 5.0, types.RuntimeType{Type: types.NUM}) {
+		t.Fatal("expected the binding to shadow the outer variable")
+	}
+
+	if variable, _ := arm.Get("k", true); variable.Data != 5.0 {
+		t.Errorf("got %v, want 5", variable.Data)
+	}
+
+	if variable, _ := env.Get("k", true); variable.Data != 1.0 {
+		t.Errorf("got %v, want the outer variable untouched", variable.Data)
+	}
+
+	if arm.Bind("k", 6.0, types.RuntimeType{Type: types.NUM}) {
+		t.Error("expected binding the same name twice in a scope to fail")
+	}
+}
+
+func TestCreateShadowsInternalDeclarations(t *testing.T) {
+	prelude := NewEnvironment(nil)
+	prelude.Create(nil, "Option", "builtin", types.RuntimeType{Type: types.ENUM}, true, false)
+
+	env := NewEnvironment(prelude)
+	if !env.Create(nil, "Option", "user", types.RuntimeType{Type: types.ENUM}, false, false) {
+		t.Fatal("expected a user declaration to shadow the internal one")
+	}
+
+	if variable, _ := env.Get("Option", true); variable.Data != "user" {
+		t.Errorf("got %v, want the user declaration", variable.Data)
+	}
+}
@@ -65,6 +65,26 @@ func (e *UmbraError) Error() string {
 	return fmt.Sprintf("%s: %s", e.code, color.RedString(e.message))
 }
 
+func (e *UmbraError) Code() string {
+	return e.code
+}
+
+func (e *UmbraError) Message() string {
+	return e.message
+}
+
+func (e *UmbraError) Node() globals.Node {
+	return e.node
+}
+
+func Annotate(err error, node globals.Node) error {
+	if umbraErr, ok := err.(*UmbraError); ok && umbraErr.node == nil {
+		umbraErr.node = node
+	}
+
+	return err
+}
+
 func NewUmbraError(code string, node globals.Node, arguments ...any) error {
 	message := fmt.Sprintf(Messages[code], arguments...)
 
@@ -43,9 +43,51 @@ var Messages = map[string]string{
 	"RT040": "cannot reassign value to constant %s",
 	"RT041": "expect number after minus sign",
 	"RT042": "expect boolean after not sign",
+	"RT043": "struct '%s' has no field '%s'",
+	"RT044": "struct '%s' expects %d arguments got %d",
+	"RT045": "cannot declare method '%s' on '%s'. expected a struct or an enum",
+	"RT046": "member '%s' already declared in '%s'",
+	"RT047": "enum member has no method '%s'",
+	"RT048": "uncaught exception: %s",
+	"RT049": "cannot propagate value of type %s. expected a Result or an Option",
+	"RT050": "pattern for enum member '%s' expects %d bindings got %d",
+	"RT051": "match guard should be a <bool> got %s instead",
+	"RT052": "non-exhaustive match over enum '%s'. missing members: %s",
+	"RT053": "no match arm for value %s",
+	"RT054": "cannot iterate over value of type %s",
+	"RT055": "range %s should be a <num> got %s instead",
+	"RT056": "range step cannot be zero",
+	"RT057": "cannot check membership in value of type %s",
+	"RT058": "operator %s expects integral <num> operands got %s and %s",
+	"RT059": "shift count cannot be negative: %v",
+	"RT060": "operator %s expects <num> operands got %s and %s",
+	"RT061": "non-null assertion failed: '%s' is null",
+	"RT062": "if condition should be a <bool> got %s instead",
+	"RT063": "cannot spread value of type %s. expected %s",
+	"RT064": "spread is only allowed in call arguments, array and hashmap literals",
+	"RT065": "enum member '%s' expects %d arguments got %d",
+	"RT066": "missing argument '%s' in call to %s",
+	"RT067": "too many arguments in call to %s. expected at most %d got %d",
+	"RT068": "unknown argument '%s' in call to %s",
+	"RT069": "argument '%s' given more than once in call to %s",
+	"RT070": "named argument '%s' is only allowed when calling a function",
+	"RT071": "cannot slice value of type %s",
+	"RT073": "void function %s cannot return a value",
+	"RT074": "missing return in %s. expected a value of type %s",
+	"RT075": "cannot infer the type of '%s' from null, add a type annotation",
+	"RT076": "members '%s' and '%s' of enum '%s' have the same backing value",
+	"RT077": "enum '%s' has no static member '%s'",
+	"RT078": "'%s' is not an interface",
+	"RT079": "cannot implement '%s' for '%s'. expected a struct or an enum",
+	"RT080": "'%s' does not implement '%s'. missing method %s",
+	"RT081": "'%s' does not implement '%s'. method %s does not match %s",
+	"RT082": "'%s' is bound more than once in the same pattern",
+	"RT083": "range has more than %d elements",
+	"RT084": "'?' can only be used inside a function",
 	"GN001": "cannot find module '%s'",
 	"GN002": "unable to load file '%s'. module does not exits. path: %s",
 	"TY000": "type %s is invalid",
 	"TY001": "expected %s got %s",
 	"TY002": "cannot use '%s' as a type",
+	"TY003": "expected %s got %s at %s",
 }
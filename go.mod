@@ -3,9 +3,12 @@ module github.com/pmqueiroz/umbra
 go 1.21.6
 
 require (
-	github.com/fatih/color v1.18.0 // indirect
+	github.com/fatih/color v1.18.0
+	github.com/sanity-io/litter v1.5.5
+)
+
+require (
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
-	github.com/sanity-io/litter v1.5.5 // indirect
 	golang.org/x/sys v0.28.0 // indirect
 )
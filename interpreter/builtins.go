@@ -0,0 +1,50 @@
+package interpreter
+
+import (
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/tokens"
+	"github.com/pmqueiroz/umbra/types"
+)
+
+type builtinMember struct {
+	name  string
+	arity int
+}
+
+func builtinEnum(name string, members ...builtinMember) *EnumType {
+	stmt := ast.EnumStatement{
+		Name:    tokens.Token{Type: tokens.IDENTIFIER, Lexeme: name},
+		Members: make(map[string]ast.EnumMember),
+	}
+
+	for ordinal, member := range members {
+		var args []ast.EnumArgument
+		for i := 0; i < member.arity; i++ {
+			args = append(args, ast.EnumArgument{Type: types.ANY})
+		}
+
+		stmt.Members[member.name] = ast.EnumMember{
+			Name:      member.name,
+			Arguments: args,
+			Ordinal:   ordinal,
+		}
+		stmt.Order = append(stmt.Order, member.name)
+	}
+
+	return newEnumType(stmt)
+}
+
+var resultEnum = builtinEnum("Result", builtinMember{"Ok", 1}, builtinMember{"Err", 1})
+
+var optionEnum = builtinEnum("Option", builtinMember{"Some", 1}, builtinMember{"None", 0})
+
+func NewGlobalEnvironment() *environment.Environment {
+	prelude := environment.NewEnvironment(nil)
+
+	prelude.Create(nil, resultEnum.Name.Lexeme, resultEnum, types.RuntimeType{Type: types.ENUM, Parent: resultEnum}, true, false)
+	prelude.Create(nil, optionEnum.Name.Lexeme, optionEnum, types.RuntimeType{Type: types.ENUM, Parent: optionEnum}, true, false)
+	prelude.Create(nil, errorStruct.Name.Lexeme, errorStruct, types.RuntimeType{Type: types.STRUCT, Parent: errorStruct}, true, false)
+
+	return environment.NewEnvironment(prelude)
+}
@@ -0,0 +1,186 @@
+package interpreter
+
+import (
+	"reflect"
+
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/globals"
+	"github.com/pmqueiroz/umbra/native"
+	"github.com/pmqueiroz/umbra/types"
+)
+
+type EnumType struct {
+	ast.EnumStatement
+	methodSet
+	ResolvedArguments map[string][]types.RuntimeType
+}
+
+func newEnumType(stmt ast.EnumStatement) *EnumType {
+	enum := &EnumType{
+		EnumStatement:     stmt,
+		methodSet:         newMethodSet(),
+		ResolvedArguments: make(map[string][]types.RuntimeType),
+	}
+
+	// members are copied so declaring the enum again leaves the parsed statement untouched
+	enum.Members = make(map[string]ast.EnumMember, len(stmt.Members))
+	for name, member := range stmt.Members {
+		member.Arguments = append([]ast.EnumArgument{}, member.Arguments...)
+		member.Parent = enum
+		enum.Members[name] = member
+	}
+
+	return enum
+}
+
+func resolveEnumArguments(enum *EnumType, env *environment.Environment) error {
+	for name, annotations := range enum.ArgumentTypes {
+		member := enum.Members[name]
+
+		for i, annotation := range annotations {
+			argumentType, err := parseRuntimeType(annotation, env)
+			if err != nil {
+				return err
+			}
+
+			member.Arguments[i].Type = argumentType.Type
+			enum.ResolvedArguments[name] = append(enum.ResolvedArguments[name], argumentType)
+		}
+	}
+
+	return nil
+}
+
+func resolveBackingValues(enum *EnumType, env *environment.Environment) error {
+	for _, name := range enum.Order {
+		expression, ok := enum.BackingValues[name]
+		if !ok {
+			continue
+		}
+
+		value, err := Evaluate(expression, env)
+		if err != nil {
+			return err
+		}
+
+		for _, other := range enum.OrderedMembers() {
+			if other.Value != nil && reflect.DeepEqual(other.Value, value) {
+				return exception.NewUmbraError("RT076", expression, other.Name, name, enum.Name.Lexeme)
+			}
+		}
+
+		member := enum.Members[name]
+		member.Value = value
+		enum.Members[name] = member
+	}
+
+	return nil
+}
+
+func enumFrom(enum *EnumType, value interface{}) (ast.EnumMember, bool) {
+	for _, member := range enum.OrderedMembers() {
+		if member.Value != nil && len(member.Arguments) == 0 && reflect.DeepEqual(member.Value, value) {
+			return member, true
+		}
+	}
+
+	if name, ok := value.(string); ok {
+		if member, ok := enum.Members[name]; ok && len(member.Arguments) == 0 {
+			return member, true
+		}
+	}
+
+	return ast.EnumMember{}, false
+}
+
+func enumStatic(enum *EnumType, expr ast.NamespaceMemberExpression) (interface{}, error) {
+	switch expr.Property.Lexeme {
+	case "from":
+		return native.InternalModuleFn(func(args []interface{}) (interface{}, error) {
+			if len(args) == 0 {
+				return nil, exception.NewUmbraError("RT066", expr, "value", expr.Reference())
+			}
+
+			if len(args) > 1 {
+				return nil, exception.NewUmbraError("RT067", expr, expr.Reference(), 1, len(args))
+			}
+
+			member, ok := enumFrom(enum, args[0])
+			return native.Option{Value: member, Present: ok}, nil
+		}), nil
+	case "members":
+		return native.InternalModuleFn(func(args []interface{}) (interface{}, error) {
+			members := []interface{}{}
+			for _, member := range enum.OrderedMembers() {
+				members = append(members, member)
+			}
+
+			return members, nil
+		}), nil
+	}
+
+	return nil, exception.NewUmbraError("RT077", expr, enum.Name.Lexeme, expr.Property.Lexeme)
+}
+
+func enumMemberProperty(member ast.EnumMember, name string) (interface{}, bool) {
+	switch name {
+	case "name":
+		return member.Name, true
+	case "ordinal":
+		return float64(member.Ordinal), true
+	case "value":
+		return member.Value, true
+	}
+
+	return nil, false
+}
+
+func checkEnumArgument(member ast.EnumMember, index int, value interface{}, node globals.Node) error {
+	if enum, ok := member.Parent.(*EnumType); ok {
+		if argumentTypes := enum.ResolvedArguments[member.Name]; index < len(argumentTypes) {
+			return checkRuntimeType(argumentTypes[index], value, node)
+		}
+	}
+
+	return types.CheckPrimitiveType(member.Arguments[index].Type, value, false, node)
+}
+
+func isMemberOf(value interface{}, enum *EnumType, names ...string) bool {
+	member, ok := value.(ast.EnumMember)
+	if !ok || member.Parent != globals.Node(enum) {
+		return false
+	}
+
+	if len(names) == 0 {
+		return true
+	}
+
+	for _, name := range names {
+		if member.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func instantiateMember(enum *EnumType, name string, args ...interface{}) ast.EnumMember {
+	member := enum.Members[name]
+	arguments := make([]ast.EnumArgument, len(member.Arguments))
+
+	for i, arg := range member.Arguments {
+		arguments[i] = ast.EnumArgument{
+			Type:  arg.Type,
+			Value: args[i],
+		}
+	}
+
+	return ast.EnumMember{
+		Name:      member.Name,
+		Arguments: arguments,
+		Parent:    member.Parent,
+		Ordinal:   member.Ordinal,
+	}
+}
@@ -68,6 +68,12 @@ func stringConversion(value interface{}, expr ast.Expression) (string, error) {
 		return strconv.FormatBool(v), nil
 	case string:
 		return v, nil
+	case *StructInstance:
+		return v.String(), nil
+	case Range:
+		return v.String(), nil
+	case ast.EnumMember:
+		return v.String(), nil
 	}
 
 	return "", exception.NewUmbraError("RT028", expr, types.SafeParseUmbraType(value), "<str>")
@@ -93,7 +99,7 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 
 		switch target := expr.Target.(type) {
 		case ast.VariableExpression:
-			variable, exists := env.Get(target.Name.Lexeme, true)
+			variable, exists := env.GetDeclared(target.Name.Lexeme, true)
 
 			if !exists {
 				return nil, exception.NewUmbraError("RT002", expr, target.Name.Lexeme)
@@ -103,7 +109,7 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 				return nil, exception.NewUmbraError("RT040", expr, target.Name.Lexeme)
 			}
 
-			typeErr := types.CheckPrimitiveType(variable.DataType, value, variable.Nullable, expr)
+			typeErr := checkRuntimeType(variable.DataType, value, expr)
 
 			if typeErr != nil {
 				return nil, typeErr
@@ -123,27 +129,50 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 				return nil, err
 			}
 
+			if targetType, ok := resolveTargetType(target, env); ok {
+				if typeErr := checkRuntimeType(targetType, value, expr); typeErr != nil {
+					return nil, typeErr
+				}
+			}
+
 			switch obj := object.(type) {
 			case map[interface{}]interface{}:
+				if hashmapType, ok := resolveTargetType(target.Object, env); ok && len(hashmapType.Arguments) == 2 {
+					if typeErr := checkRuntimeType(hashmapType.Arguments[0], property, expr); typeErr != nil {
+						return nil, typeErr
+					}
+				}
+
 				obj[property] = value
+				return value, nil
+			case *StructInstance:
+				name, ok := property.(string)
+				if !ok {
+					return nil, exception.NewUmbraError("RT020", expr)
+				}
+
+				if err := obj.Set(name, value, expr); err != nil {
+					return nil, err
+				}
+
 				return value, nil
 			case []interface{}:
 				index, err := Evaluate(target.Property, env)
 				if err != nil {
 					return nil, err
 				}
-				idx, ok := index.(float64)
-				if !ok {
-					return nil, exception.NewUmbraError("RT003", expr, index)
+				idx, err := normalizeIndex(index, len(obj), expr)
+				if err != nil {
+					return nil, err
 				}
-				if int(idx) < 0 || int(idx) > len(obj) {
-					return nil, exception.NewUmbraError("RT004", expr, idx)
+				if idx < 0 || idx > len(obj) {
+					return nil, exception.NewUmbraError("RT004", expr, index)
 				}
-				if int(idx) == len(obj) {
+				if idx == len(obj) {
 					env.Set(target.Object.(ast.VariableExpression).Name.Lexeme, append(obj, value))
 					return value, nil
 				}
-				obj[int(idx)] = value
+				obj[idx] = value
 				return value, nil
 			default:
 				return nil, exception.NewUmbraError("RT005", expr, types.SafeParseUmbraType(obj))
@@ -264,7 +293,7 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 			switch leftVal := left.(type) {
 			case ast.EnumMember:
 				if rightVal, ok := right.(ast.EnumMember); ok {
-					if leftVal.Signature == rightVal.Signature && leftVal.Name == rightVal.Name {
+					if leftVal.Parent == rightVal.Parent && leftVal.Name == rightVal.Name {
 						for i, arg := range leftVal.Arguments {
 							if arg.Value != rightVal.Arguments[i].Value {
 								return false, nil
@@ -283,6 +312,10 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 			}
 		case tokens.BANG_EQUAL:
 			return left != right, nil
+		case tokens.IN:
+			return contains(right, left, expr)
+		case tokens.AMPERSAND, tokens.PIPE, tokens.CARET, tokens.LESS_LESS, tokens.GREATER_GREATER, tokens.SLASH_SLASH, tokens.STAR_STAR:
+			return evaluateArithmetic(expr, left, right)
 		case tokens.ENUMOF:
 			leftVal, ok := left.(ast.EnumMember)
 			if !ok {
@@ -293,7 +326,7 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 				return nil, exception.NewUmbraError("RT038", expr)
 			}
 
-			return leftVal.Signature == rightVal.Signature && leftVal.Name == rightVal.Name, nil
+			return leftVal.Parent == rightVal.Parent && leftVal.Name == rightVal.Name, nil
 		default:
 			return nil, exception.NewUmbraError("RT010", expr, expr.Operator.Lexeme)
 		}
@@ -315,6 +348,12 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 			}
 			return nil, exception.NewUmbraError("RT042", expr)
 		case tokens.TYPE_OF:
+			switch right.(type) {
+			case *StructInstance, ast.EnumMember, FunctionDeclaration, native.InternalModuleFn,
+				*StructType, *EnumType, *InterfaceType, ast.TypeAliasStatement:
+				return runtimeTypeOf(right), nil
+			}
+
 			parsedType, err := types.ParseUmbraType(right)
 
 			if err != nil {
@@ -329,9 +368,11 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 			case []string:
 				return float64(len(parsedRight)), nil
 			case string:
-				return float64(len(parsedRight)), nil
+				return float64(utf8.RuneCountInString(parsedRight)), nil
 			case map[interface{}]interface{}:
 				return float64(len(parsedRight)), nil
+			case Range:
+				return float64(parsedRight.Len()), nil
 			default:
 				return nil, exception.NewUmbraError("RT011", expr, types.SafeParseUmbraType(parsedRight))
 			}
@@ -345,7 +386,7 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 				}
 				return result, nil
 			case map[interface{}]interface{}:
-				var result [][]interface{}
+				result := []interface{}{}
 				for key, value := range parsedRight {
 					result = append(result, []interface{}{key, value})
 				}
@@ -373,6 +414,10 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 			return nil, err
 		}
 
+		if callee == nil && expr.Optional {
+			return nil, ShortCircuit{}
+		}
+
 		switch parsedCallee := callee.(type) {
 		case FunctionDeclaration:
 			value, err := processFunctionCall(parsedCallee, expr.Arguments, env)
@@ -381,15 +426,11 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 				return returnValue.Value, nil
 			}
 
-			return value, err
+			return value, exception.Annotate(err, expr)
 		case native.InternalModuleFn:
-			var args []interface{}
-			for _, arg := range expr.Arguments {
-				argValue, err := Evaluate(arg, env)
-				if err != nil {
-					return nil, err
-				}
-				args = append(args, argValue)
+			args, err := evaluateSpreadable(expr.Arguments, env)
+			if err != nil {
+				return nil, err
 			}
 
 			defer func() {
@@ -398,16 +439,38 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 				}
 			}()
 			result, err := parsedCallee(args)
-			return result, err
+
+			switch r := result.(type) {
+			case native.Result:
+				if r.Err != nil {
+					return instantiateMember(resultEnum, "Err", newErrorValue(exception.Annotate(r.Err, expr))), nil
+				}
+
+				return instantiateMember(resultEnum, "Ok", r.Value), nil
+			case native.Option:
+				if r.Present {
+					return instantiateMember(optionEnum, "Some", r.Value), nil
+				}
+
+				return instantiateMember(optionEnum, "None"), nil
+			}
+
+			return result, exception.Annotate(err, expr)
 		case ast.EnumMember:
+			args, err := evaluateSpreadable(expr.Arguments, env)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(args) != len(parsedCallee.Arguments) {
+				return nil, exception.NewUmbraError("RT065", expr, parsedCallee.Name, len(parsedCallee.Arguments), len(args))
+			}
+
 			enrichedArgs := make([]ast.EnumArgument, len(parsedCallee.Arguments))
 			for i, arg := range parsedCallee.Arguments {
-				argValue, err := Evaluate(expr.Arguments[i], env)
-				if err != nil {
-					return nil, err
-				}
+				argValue := args[i]
 
-				typeErr := types.CheckPrimitiveType(arg.Type, argValue, false, expr)
+				typeErr := checkEnumArgument(parsedCallee, i, argValue, expr)
 				if typeErr != nil {
 					return nil, typeErr
 				}
@@ -420,8 +483,16 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 			return ast.EnumMember{
 				Name:      parsedCallee.Name,
 				Arguments: enrichedArgs,
-				Signature: parsedCallee.Signature,
+				Parent:    parsedCallee.Parent,
+				Ordinal:   parsedCallee.Ordinal,
 			}, nil
+		case *StructType:
+			args, err := evaluateSpreadable(expr.Arguments, env)
+			if err != nil {
+				return nil, err
+			}
+
+			return instantiateStruct(parsedCallee, args, expr)
 		default:
 			return nil, exception.NewUmbraError("RT014", expr, expr.Callee.Reference())
 		}
@@ -432,6 +503,10 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 		}
 
 		switch expr.Operator.Type {
+		case tokens.HOOK_HOOK:
+			if left != nil {
+				return left, nil
+			}
 		case tokens.OR:
 			if left.(bool) {
 				return true, nil
@@ -451,35 +526,23 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 
 		return right, nil
 	case ast.ArrayExpression:
-		var elements []interface{}
-		for _, element := range expr.Elements {
-			evaluatedElement, err := Evaluate(element, env)
-			if err != nil {
-				return nil, err
-			}
-			elements = append(elements, evaluatedElement)
-		}
-		return elements, nil
+		return evaluateSpreadable(expr.Elements, env)
 	case ast.HashmapExpression:
-		hashmap := make(map[interface{}]interface{})
-		for keyExpr, valueExpr := range expr.Pairs {
-			key, err := Evaluate(keyExpr, env)
-			if err != nil {
-				return nil, err
-			}
-			value, err := Evaluate(valueExpr, env)
-			if err != nil {
-				return nil, err
-			}
-			hashmap[key] = value
-		}
-		return hashmap, nil
+		return evaluateHashmap(expr, env)
+	case ast.SpreadExpression:
+		return nil, exception.NewUmbraError("RT064", expr)
+	case ast.NamedArgument:
+		return nil, exception.NewUmbraError("RT070", expr, expr.Name.Lexeme)
 	case ast.MemberExpression:
 		object, err := Evaluate(expr.Object, env)
 		if err != nil {
 			return nil, err
 		}
 
+		if object == nil && expr.Optional {
+			return nil, ShortCircuit{}
+		}
+
 		property, err := resolveMemberExpressionProperty(expr, env)
 
 		if err != nil {
@@ -493,33 +556,54 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 				return nil, nil
 			}
 			return value, nil
-		case string:
+		case Range:
 			index, err := Evaluate(expr.Property, env)
 			if err != nil {
 				return nil, err
 			}
-			idx, ok := index.(float64)
+			idx, err := normalizeIndex(index, obj.Len(), expr)
+			if err != nil {
+				return nil, err
+			}
+			value, ok := obj.At(idx)
 			if !ok {
-				return nil, exception.NewUmbraError("RT003", expr, index)
+				return nil, exception.NewUmbraError("RT004", expr, index)
 			}
-
-			value := []rune(obj)[int(idx)]
-
 			return value, nil
-		case []interface{}, []string:
+		case string, []interface{}, []string:
 			index, err := Evaluate(expr.Property, env)
 			if err != nil {
 				return nil, err
 			}
-			idx, ok := index.(float64)
+
+			return indexSequence(obj, index, expr)
+		case *StructInstance:
+			name, ok := property.(string)
 			if !ok {
-				return nil, exception.NewUmbraError("RT003", expr, index)
+				return nil, exception.NewUmbraError("RT020", expr)
 			}
-			if int(idx) < 0 || int(idx) >= getLength(obj) {
-				return nil, exception.NewUmbraError("RT004", expr, idx)
+
+			if method, ok := getMethod(obj, name); ok {
+				return method, nil
 			}
-			return getElementAt(obj, int(idx)), nil
-		case ast.EnumStatement:
+
+			return obj.Get(name, expr)
+		case ast.EnumMember:
+			name, ok := property.(string)
+			if !ok {
+				return nil, exception.NewUmbraError("RT020", expr)
+			}
+
+			if method, ok := getMethod(obj, name); ok {
+				return method, nil
+			}
+
+			if value, ok := enumMemberProperty(obj, name); ok {
+				return value, nil
+			}
+
+			return nil, exception.NewUmbraError("RT047", expr, name)
+		case *EnumType:
 			if prop, ok := expr.Property.(ast.VariableExpression); ok {
 				member, ok := obj.Get(prop.Name)
 				if !ok {
@@ -534,6 +618,12 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 		}
 	case ast.NamespaceMemberExpression:
 		if variableExpr, ok := expr.Namespace.(ast.VariableExpression); ok {
+			if variable, ok := env.Get(variableExpr.Name.Lexeme, true); ok {
+				if enum, ok := variable.Data.(*EnumType); ok {
+					return enumStatic(enum, expr)
+				}
+			}
+
 			namespace, ok := env.GetNamespace(variableExpr.Name.Lexeme)
 			if !ok {
 				return nil, exception.NewUmbraError("RT018", expr, variableExpr.Name.Lexeme)
@@ -592,7 +682,7 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 			case rune:
 				return float64(v), nil
 			case string:
-				value, err := strconv.ParseFloat(v, 64)
+				value, err := tokens.ParseNumeric(v)
 				if err != nil {
 					return math.NaN(), nil
 				}
@@ -604,20 +694,78 @@ func Evaluate(expression ast.Expression, env *environment.Environment) (interfac
 		return nil, defaultError
 	case ast.FunctionExpression:
 		return processFunction(expr, env)
-	case ast.IsExpression:
-		expected, err := types.ParseTokenType(expr.Expected.Type)
+	case ast.MatchExpression:
+		return evaluateMatch(expr, env)
+	case ast.PropagateExpression:
+		value, err := Evaluate(expr.Expression, env)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case isMemberOf(value, resultEnum, "Ok"), isMemberOf(value, optionEnum, "Some"):
+			return value.(ast.EnumMember).Arguments[0].Value, nil
+		case isMemberOf(value, resultEnum, "Err"), isMemberOf(value, optionEnum, "None"):
+			return nil, Return{Value: value, Node: expr}
+		default:
+			return nil, exception.NewUmbraError("RT049", expr, runtimeTypeOf(value))
+		}
+	case ast.TemplateExpression:
+		var result strings.Builder
+
+		for _, part := range expr.Parts {
+			value, err := Evaluate(part, env)
+			if err != nil {
+				return nil, err
+			}
+
+			stringified, err := stringConversion(value, part)
+			if err != nil {
+				return nil, err
+			}
+
+			result.WriteString(stringified)
+		}
 
+		return result.String(), nil
+	case ast.SliceExpression:
+		return evaluateSlice(expr, env)
+	case ast.IfExpression:
+		return evaluateIf(expr.Condition, expr.ThenBranch, expr.ElseBranch, env, expr)
+	case ast.OptionalChainExpression:
+		value, err := Evaluate(expr.Expression, env)
+		if _, ok := err.(ShortCircuit); ok {
+			return nil, nil
+		}
+
+		return value, err
+	case ast.AssertExpression:
+		value, err := Evaluate(expr.Expression, env)
 		if err != nil {
 			return nil, err
 		}
 
+		if value == nil {
+			return nil, exception.NewUmbraError("RT061", expr, expr.Expression.Reference())
+		}
+
+		return value, nil
+	case ast.RangeExpression:
+		return evaluateRange(expr, env)
+	case ast.IsExpression:
+		expected, err := parseRuntimeType(expr.Expected, env)
+
+		if err != nil {
+			return nil, exception.Annotate(err, expr)
+		}
+
 		value, err := Evaluate(expr.Expr, env)
 
 		if err != nil {
 			return nil, err
 		}
 
-		err = types.CheckPrimitiveType(expected, value, false, expr)
+		err = checkRuntimeType(expected, value, expr)
 
 		return err == nil, nil
 	default:
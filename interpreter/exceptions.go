@@ -0,0 +1,104 @@
+package interpreter
+
+import (
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/globals"
+	"github.com/pmqueiroz/umbra/tokens"
+)
+
+func builtinField(name string, t tokens.TokenType, nullable bool) ast.StructField {
+	return ast.StructField{
+		Name: tokens.Token{Type: tokens.IDENTIFIER, Lexeme: name},
+		Type: ast.TypeAnnotation{
+			Token:    tokens.Token{Type: t, Lexeme: name},
+			Nullable: nullable,
+		},
+	}
+}
+
+var errorStruct = func() *StructType {
+	parent := newStructType(ast.StructStatement{
+		Name: tokens.Token{Type: tokens.IDENTIFIER, Lexeme: "Error"},
+		Fields: []ast.StructField{
+			builtinField("code", tokens.STR_TYPE, false),
+			builtinField("message", tokens.STR_TYPE, false),
+			builtinField("line", tokens.NUM_TYPE, true),
+			builtinField("column", tokens.NUM_TYPE, true),
+			builtinField("value", tokens.ANY_TYPE, true),
+		},
+	})
+
+	// fields are all primitives, no scope is needed to resolve them
+	resolveStructFields(parent, nil)
+	return parent
+}()
+
+func isErrorValue(value interface{}) bool {
+	instance, ok := value.(*StructInstance)
+
+	return ok && instance.Parent == errorStruct
+}
+
+func isCatchable(err error) bool {
+	switch err.(type) {
+	case Return, Break, Continue:
+		return false
+	default:
+		return true
+	}
+}
+
+func describeThrown(value interface{}) string {
+	if isErrorValue(value) {
+		instance := value.(*StructInstance)
+		return instance.Fields["code"].(string) + ": " + instance.Fields["message"].(string)
+	}
+
+	message, err := stringConversion(value, nil)
+	if err != nil {
+		return string(runtimeTypeOf(value))
+	}
+
+	return message
+}
+
+func newErrorValue(err error) *StructInstance {
+	fields := map[string]interface{}{
+		"code":    "",
+		"message": err.Error(),
+		"line":    nil,
+		"column":  nil,
+		"value":   nil,
+	}
+
+	var node globals.Node
+
+	switch e := err.(type) {
+	case Throw:
+		if isErrorValue(e.Value) {
+			return e.Value.(*StructInstance)
+		}
+
+		fields["code"] = "RT048"
+		fields["message"] = describeThrown(e.Value)
+		fields["value"] = e.Value
+		node = e.Node
+	case *exception.UmbraError:
+		fields["code"] = e.Code()
+		fields["message"] = e.Message()
+		node = e.Node()
+	}
+
+	if node != nil {
+		if locs := node.GetLocs(); len(locs) > 0 {
+			fields["line"] = float64(locs[0].Line)
+			fields["column"] = float64(locs[0].Range.From)
+		}
+	}
+
+	return &StructInstance{
+		Parent: errorStruct,
+		Fields: fields,
+	}
+}
@@ -3,28 +3,52 @@ package interpreter
 import (
 	"github.com/pmqueiroz/umbra/ast"
 	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
 	"github.com/pmqueiroz/umbra/types"
 )
 
-func processFunction(funcExpr ast.FunctionExpression, env *environment.Environment) (FunctionDeclaration, error) {
-	parsedReturnType, parentEnum, err := parseRuntimeType(funcExpr.ReturnType, env)
+func newFunctionDeclaration(funcExpr ast.FunctionExpression, env *environment.Environment) (FunctionDeclaration, error) {
+	parsedReturnType, err := parseRuntimeType(funcExpr.ReturnType, env)
 
 	if err != nil {
 		return FunctionDeclaration{}, err
 	}
 
-	fun := FunctionDeclaration{Itself: &funcExpr, Environment: env, ReturnType: struct {
-		Type   types.UmbraType
-		Parent ast.EnumStatement
-	}{Type: parsedReturnType, Parent: parentEnum}}
+	return FunctionDeclaration{Itself: &funcExpr, Environment: env, ReturnType: parsedReturnType}, nil
+}
+
+func functionRuntimeType(fn FunctionDeclaration) (types.RuntimeType, error) {
+	if fn.Itself == nil {
+		return types.RuntimeType{Type: types.FUN}, nil
+	}
+
+	signature := types.RuntimeType{Type: types.FUN, Return: &fn.ReturnType}
+
+	for _, param := range fn.Itself.Params {
+		paramType, err := parseRuntimeType(param.Type, fn.Environment)
+		if err != nil {
+			return types.RuntimeType{}, err
+		}
+
+		signature.Arguments = append(signature.Arguments, paramType)
+	}
+
+	return signature, nil
+}
+
+func processFunction(funcExpr ast.FunctionExpression, env *environment.Environment) (FunctionDeclaration, error) {
+	fun, err := newFunctionDeclaration(funcExpr, env)
+
+	if err != nil {
+		return FunctionDeclaration{}, err
+	}
 
 	if funcExpr.Name.Lexeme != "" {
 		env.Create(
 			funcExpr,
 			funcExpr.Name.Lexeme,
 			fun,
-			types.FUN,
-			false,
+			types.RuntimeType{Type: types.FUN},
 			false,
 			false,
 		)
@@ -33,65 +57,147 @@ func processFunction(funcExpr ast.FunctionExpression, env *environment.Environme
 	return fun, nil
 }
 
-func resolveArgs(args interface{}, env *environment.Environment) ([]interface{}, error) {
+func resolveArgs(args interface{}, env *environment.Environment, signature string) ([]interface{}, map[string]interface{}, error) {
 	result := make([]interface{}, 0)
+	named := make(map[string]interface{})
 
 	switch args := args.(type) {
 	case []ast.Expression:
+		var positional []ast.Expression
+
 		for _, arg := range args {
-			value, err := Evaluate(arg, env)
+			namedArg, ok := arg.(ast.NamedArgument)
+			if !ok {
+				positional = append(positional, arg)
+				continue
+			}
+
+			if _, exists := named[namedArg.Name.Lexeme]; exists {
+				return nil, nil, exception.NewUmbraError("RT069", namedArg, namedArg.Name.Lexeme, signature)
+			}
+
+			value, err := Evaluate(namedArg.Value, env)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
-			result = append(result, value)
+			named[namedArg.Name.Lexeme] = value
 		}
+
+		values, err := evaluateSpreadable(positional, env)
+		return values, named, err
 	case []ast.EnumArgument:
 		for _, arg := range args {
 			result = append(result, arg.Value)
 		}
 	}
 
-	return result, nil
+	return result, named, nil
 }
 
 func processFunctionCall(callee FunctionDeclaration, args interface{}, env *environment.Environment) (interface{}, error) {
 	funcEnv := environment.NewEnvironment(callee.Environment)
-	parsedArgs, err := resolveArgs(args, env)
+	signature := callee.Itself.Signature()
+	parsedArgs, namedArgs, err := resolveArgs(args, env, signature)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if callee.This != nil {
+		funcEnv.Create(nil, "this", callee.This, types.RuntimeType{Type: runtimeTypeOf(callee.This)}, false, false)
+	}
+
+	variadic := false
+
 	for i, param := range callee.Itself.Params {
+		paramType, err := parseRuntimeType(param.Type, callee.Environment)
+		if err != nil {
+			return nil, err
+		}
+
 		if param.Variadic {
-			var variadicArgs []interface{}
+			variadic = true
+			variadicArgs := []interface{}{}
 			for j := i; j < len(parsedArgs); j++ {
-				typeErr := types.CheckPrimitiveType(param.Type, parsedArgs[j], param.Nullable, nil)
+				typeErr := checkRuntimeType(paramType, parsedArgs[j], nil)
 				if typeErr != nil {
 					return nil, typeErr
 				}
 
 				variadicArgs = append(variadicArgs, parsedArgs[j])
 			}
-			funcEnv.Create(nil, param.Name.Lexeme, variadicArgs, param.Type, param.Nullable, false, false)
+			funcEnv.Create(nil, param.Name.Lexeme, variadicArgs, types.RuntimeType{Type: types.ARR, Arguments: []types.RuntimeType{paramType}}, false, false)
 			break
-		} else {
-			typeErr := types.CheckPrimitiveType(param.Type, parsedArgs[i], param.Nullable, nil)
-			if typeErr != nil {
-				return nil, typeErr
+		}
+
+		namedValue, isNamed := namedArgs[param.Name.Lexeme]
+		delete(namedArgs, param.Name.Lexeme)
+
+		var value interface{}
+
+		switch {
+		case i < len(parsedArgs):
+			if isNamed {
+				return nil, exception.NewUmbraError("RT069", nil, param.Name.Lexeme, signature)
 			}
+			value = parsedArgs[i]
+		case isNamed:
+			value = namedValue
+		case param.Default != nil:
+			value, err = Evaluate(param.Default, funcEnv)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, exception.NewUmbraError("RT066", nil, param.Name.Lexeme, signature)
+		}
 
-			funcEnv.Create(nil, param.Name.Lexeme, parsedArgs[i], param.Type, param.Nullable, false, false)
+		typeErr := checkRuntimeType(paramType, value, nil)
+		if typeErr != nil {
+			return nil, typeErr
 		}
+
+		funcEnv.Create(nil, param.Name.Lexeme, value, paramType, false, false)
+	}
+
+	if !variadic && len(parsedArgs) > len(callee.Itself.Params) {
+		return nil, exception.NewUmbraError("RT067", nil, signature, len(callee.Itself.Params), len(parsedArgs))
+	}
+
+	for name := range namedArgs {
+		return nil, exception.NewUmbraError("RT068", nil, name, signature)
 	}
 
-	var result interface{}
 	for _, stmt := range callee.Itself.Body {
 		if err := Interpret(stmt, funcEnv); err != nil {
+			if returned, ok := err.(Return); ok {
+				return nil, checkReturn(callee, returned)
+			}
+
 			return nil, err
 		}
 	}
 
-	return result, nil
+	if callee.ReturnType.Type != types.VOID {
+		return nil, exception.NewUmbraError("RT074", nil, signature, formatRuntimeType(callee.ReturnType))
+	}
+
+	return nil, nil
+}
+
+func checkReturn(callee FunctionDeclaration, returned Return) error {
+	if callee.ReturnType.Type == types.VOID {
+		if statement, ok := returned.Node.(ast.ReturnStatement); ok && statement.Value != nil {
+			return exception.NewUmbraError("RT073", returned.Node, callee.Itself.Signature())
+		}
+
+		return returned
+	}
+
+	if err := checkRuntimeType(callee.ReturnType, returned.Value, returned.Node); err != nil {
+		return err
+	}
+
+	return returned
 }
@@ -0,0 +1,100 @@
+package interpreter
+
+import (
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/types"
+)
+
+type InterfaceType struct {
+	ast.InterfaceStatement
+	MethodTypes map[string]types.RuntimeType
+}
+
+func declareInterface(stmt ast.InterfaceStatement, env *environment.Environment) error {
+	iface := &InterfaceType{
+		InterfaceStatement: stmt,
+		MethodTypes:        make(map[string]types.RuntimeType),
+	}
+
+	env.Create(
+		stmt,
+		stmt.Name.Lexeme,
+		iface,
+		types.RuntimeType{Type: types.INTERFACE, Parent: iface},
+		false,
+		false,
+	)
+
+	for _, method := range stmt.Methods {
+		methodType := types.RuntimeType{Type: types.FUN}
+
+		for _, param := range method.Params {
+			paramType, err := parseRuntimeType(param.Type, env)
+			if err != nil {
+				return err
+			}
+
+			methodType.Arguments = append(methodType.Arguments, paramType)
+		}
+
+		returnType, err := parseRuntimeType(method.ReturnType, env)
+		if err != nil {
+			return err
+		}
+
+		methodType.Return = &returnType
+		iface.MethodTypes[method.Name.Lexeme] = methodType
+	}
+
+	return nil
+}
+
+func declareImplementation(stmt ast.ImplStatement, env *environment.Environment) error {
+	declaration, ok := env.Get(stmt.Interface.Lexeme, true)
+	if !ok {
+		return exception.NewUmbraError("RT002", stmt, stmt.Interface.Lexeme)
+	}
+
+	iface, ok := declaration.Data.(*InterfaceType)
+	if !ok {
+		return exception.NewUmbraError("RT078", stmt, stmt.Interface.Lexeme)
+	}
+
+	target, ok := env.Get(stmt.Target.Lexeme, true)
+	if !ok {
+		return exception.NewUmbraError("RT002", stmt, stmt.Target.Lexeme)
+	}
+
+	var set *methodSet
+
+	switch parent := target.Data.(type) {
+	case *StructType:
+		set = &parent.methodSet
+	case *EnumType:
+		set = &parent.methodSet
+	default:
+		return exception.NewUmbraError("RT079", stmt, iface.Name.Lexeme, stmt.Target.Lexeme)
+	}
+
+	for _, method := range iface.Methods {
+		implemented, ok := set.Methods[method.Name.Lexeme]
+		if !ok {
+			return exception.NewUmbraError("RT080", stmt, stmt.Target.Lexeme, iface.Name.Lexeme, method.Signature())
+		}
+
+		if !matchesSignature(iface.MethodTypes[method.Name.Lexeme], implemented) {
+			return exception.NewUmbraError("RT081", stmt, stmt.Target.Lexeme, iface.Name.Lexeme, implemented.Itself.Signature(), method.Signature())
+		}
+	}
+
+	set.Interfaces[iface] = true
+	return nil
+}
+
+func implements(value interface{}, iface *InterfaceType) bool {
+	set, ok := methodsOf(value)
+
+	return ok && set.Interfaces[iface]
+}
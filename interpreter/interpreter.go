@@ -1,23 +1,20 @@
 package interpreter
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"os"
 	"reflect"
-	"strings"
 
 	"github.com/pmqueiroz/umbra/ast"
 	"github.com/pmqueiroz/umbra/environment"
 	"github.com/pmqueiroz/umbra/exception"
 	"github.com/pmqueiroz/umbra/globals"
-	"github.com/pmqueiroz/umbra/tokens"
 	"github.com/pmqueiroz/umbra/types"
 )
 
 type Return struct {
 	Value interface{}
+	Node  globals.Node
 }
 
 func (r Return) Error() string {
@@ -36,14 +33,26 @@ func (r Continue) Error() string {
 	return "for loop continue"
 }
 
+type ShortCircuit struct{}
+
+func (s ShortCircuit) Error() string {
+	return "optional chain short circuit"
+}
+
+type Throw struct {
+	Value interface{}
+	Node  globals.Node
+}
+
+func (t Throw) Error() string {
+	return exception.NewUmbraError("RT048", t.Node, describeThrown(t.Value)).Error()
+}
+
 type FunctionDeclaration struct {
 	Itself      *ast.FunctionExpression
 	Environment *environment.Environment
-	// temp solution while UmbraType is only a string
-	ReturnType struct {
-		Type   types.UmbraType
-		Parent ast.EnumStatement
-	}
+	ReturnType  types.RuntimeType
+	This        interface{}
 }
 
 func extractVarName(stmt ast.Statement) string {
@@ -55,31 +64,6 @@ func extractVarName(stmt ast.Statement) string {
 	}
 }
 
-func checkDeclarationType(t tokens.Token, nullable bool, value interface{}, env *environment.Environment, node globals.Node) error {
-	parsedType, enum, err := parseRuntimeType(t, env)
-
-	if err != nil {
-		return err
-	}
-
-	switch parsedType {
-	case types.ENUM:
-		if member, ok := value.(ast.EnumMember); ok {
-			if ok := enum.ValidMember(member); !ok {
-				return exception.NewUmbraError("TY001", node, enum.Name.Lexeme, value)
-			}
-		}
-	default:
-		typeErr := types.CheckPrimitiveType(parsedType, value, nullable, node)
-
-		if typeErr != nil {
-			return typeErr
-		}
-	}
-
-	return nil
-}
-
 func Interpret(statement ast.Statement, env *environment.Environment) error {
 	switch stmt := statement.(type) {
 	case ast.PrintStatement:
@@ -98,11 +82,7 @@ func Interpret(statement ast.Statement, env *environment.Environment) error {
 
 		switch v := value.(type) {
 		case string:
-			v = strings.ReplaceAll(v, "\\n", "\n")
-			v = strings.ReplaceAll(v, "\\t", "\t")
-			v = strings.ReplaceAll(v, "\\\"", "\"")
-			v = strings.ReplaceAll(v, "\\\\", "\\")
-			output = fmt.Sprint(v)
+			output = v
 		case float64:
 			output = fmt.Sprintf("%.f", v)
 		default:
@@ -119,7 +99,7 @@ func Interpret(statement ast.Statement, env *environment.Environment) error {
 				return err
 			}
 		} else {
-			value = zero(stmt.Type.Type)
+			value = zero(stmt.Type.Token.Type)
 		}
 
 		return resolveVarDeclaration(stmt, value, env)
@@ -153,6 +133,11 @@ func Interpret(statement ast.Statement, env *environment.Environment) error {
 	case ast.ModuleStatement:
 		for _, stmt := range stmt.Declarations {
 			if err := Interpret(stmt, env); err != nil {
+				if returned, ok := err.(Return); ok {
+					if propagate, ok := returned.Node.(ast.PropagateExpression); ok {
+						return exception.NewUmbraError("RT084", propagate)
+					}
+				}
 				return err
 			}
 		}
@@ -164,37 +149,33 @@ func Interpret(statement ast.Statement, env *environment.Environment) error {
 		}
 
 		if condition.(bool) {
-			return Interpret(stmt.ThenBranch, env)
+			branchEnv, err := narrow(stmt.Condition, env)
+			if err != nil {
+				return err
+			}
+
+			return Interpret(stmt.ThenBranch, branchEnv)
 		} else if stmt.ElseBranch != nil {
 			return Interpret(stmt.ElseBranch, env)
 		}
 		return nil
 	case ast.ReturnStatement:
+		if stmt.Value == nil {
+			return Return{Node: stmt}
+		}
+
 		value, err := Evaluate(stmt.Value, env)
 		if err != nil {
 			return err
 		}
-		return Return{Value: value}
+		return Return{Value: value, Node: stmt}
 	case ast.FunctionExpression:
-		parsedReturnType, parentEnum, err := parseRuntimeType(stmt.ReturnType, env)
-
-		if err != nil {
-			return err
+		if stmt.Receiver.Lexeme != "" {
+			return declareMethod(stmt, env)
 		}
 
-		env.Create(
-			stmt,
-			stmt.Name.Lexeme,
-			FunctionDeclaration{Itself: &stmt, Environment: env, ReturnType: struct {
-				Type   types.UmbraType
-				Parent ast.EnumStatement
-			}{Type: parsedReturnType, Parent: parentEnum}},
-			types.FUN,
-			false,
-			false,
-			false,
-		)
-		return nil
+		_, err := processFunction(stmt, env)
+		return err
 	case ast.ExpressionStatement:
 		_, err := Evaluate(stmt.Expression, env)
 		return err
@@ -294,6 +275,34 @@ func Interpret(statement ast.Statement, env *environment.Environment) error {
 			}
 		}
 		return nil
+	case ast.IterableForStatement:
+		return iterate(stmt, env)
+	case ast.ThrowStatement:
+		value, err := Evaluate(stmt.Value, env)
+		if err != nil {
+			return err
+		}
+		return Throw{Value: value, Node: stmt}
+	case ast.TryStatement:
+		err := Interpret(stmt.Body, env)
+
+		if err != nil && stmt.CatchBody != nil && isCatchable(err) {
+			catchEnv := environment.NewEnvironment(env)
+
+			if stmt.CatchName.Lexeme != "" {
+				catchEnv.Create(stmt, stmt.CatchName.Lexeme, newErrorValue(err), types.RuntimeType{Type: types.STRUCT, Parent: errorStruct}, false, false)
+			}
+
+			err = Interpret(stmt.CatchBody, catchEnv)
+		}
+
+		if stmt.FinallyBody != nil {
+			if finallyErr := Interpret(stmt.FinallyBody, env); finallyErr != nil {
+				return finallyErr
+			}
+		}
+
+		return err
 	case ast.BreakStatement:
 		return Break{}
 	case ast.ContinueStatement:
@@ -316,56 +325,136 @@ func Interpret(statement ast.Statement, env *environment.Environment) error {
 		env.CreateNamespace(module.Name, module.Environment)
 		return nil
 	case ast.EnumStatement:
-		hasher := sha256.New()
-		hasher.Write([]byte(stmt.Name.Lexeme))
-		for member := range stmt.Members {
-			hasher.Write([]byte(member))
-		}
-
-		stmt.Signature = hex.EncodeToString(hasher.Sum(nil))
-
-		for name, member := range stmt.Members {
-			member.Signature = stmt.Signature
-			stmt.Members[name] = member
-		}
+		enum := newEnumType(stmt)
 
 		env.Create(
 			stmt,
 			stmt.Name.Lexeme,
-			stmt,
-			types.ENUM,
-			false,
+			enum,
+			types.RuntimeType{Type: types.ENUM, Parent: enum},
 			false,
 			false,
 		)
-		return nil
-	case ast.MatchStatement:
-		value, err := Evaluate(stmt.Expression, env)
+		if err := resolveEnumArguments(enum, env); err != nil {
+			return err
+		}
+
+		return resolveBackingValues(enum, env)
+	case ast.InterfaceStatement:
+		return declareInterface(stmt, env)
+	case ast.ImplStatement:
+		return declareImplementation(stmt, env)
+	case ast.TypeAliasStatement:
+		aliased, err := parseRuntimeType(stmt.Type, env)
 		if err != nil {
 			return err
 		}
 
-		for _, matchCase := range stmt.Cases {
-			expr, err := Evaluate(matchCase.Expression, env)
+		env.Create(stmt, stmt.Name.Lexeme, stmt, aliased, false, false)
+		return nil
+	case ast.StructStatement:
+		parent := newStructType(stmt)
 
-			if err != nil {
-				return err
-			}
+		env.Create(
+			stmt,
+			stmt.Name.Lexeme,
+			parent,
+			types.RuntimeType{Type: types.STRUCT, Parent: parent},
+			false,
+			false,
+		)
 
-			if checkMatch(expr, value) {
-				callback, err := processFunction(matchCase.Callback, env)
+		return resolveStructFields(parent, env)
+	default:
+		return exception.NewUmbraError("RT000", stmt, reflect.TypeOf(statement).Name())
+	}
+}
 
-				if err != nil {
-					return err
-				}
+func evaluateBlock(block ast.Statement, env *environment.Environment) (interface{}, error) {
+	switch stmt := block.(type) {
+	case ast.ExpressionStatement:
+		return Evaluate(stmt.Expression, env)
+	case ast.IfStatement:
+		return evaluateIf(stmt.Condition, stmt.ThenBranch, stmt.ElseBranch, env, stmt)
+	}
 
-				_, err = processFunctionCall(callback, value.(ast.EnumMember).Arguments, env)
-				return err
+	blockStmt, ok := block.(ast.BlockStatement)
+	if !ok {
+		return nil, Interpret(block, env)
+	}
+
+	blockEnv := environment.NewEnvironment(env)
+
+	for i, stmt := range blockStmt.Statements {
+		if i == len(blockStmt.Statements)-1 {
+			switch stmt.(type) {
+			case ast.ExpressionStatement, ast.IfStatement:
+				return evaluateBlock(stmt, blockEnv)
 			}
 		}
 
-		return nil
-	default:
-		return exception.NewUmbraError("RT000", stmt, reflect.TypeOf(statement).Name())
+		if err := Interpret(stmt, blockEnv); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func narrow(condition ast.Expression, env *environment.Environment) (*environment.Environment, error) {
+	for {
+		grouping, ok := condition.(ast.GroupingExpression)
+		if !ok {
+			break
+		}
+
+		condition = grouping.Expression
 	}
+
+	isExpr, ok := condition.(ast.IsExpression)
+	if !ok {
+		return env, nil
+	}
+
+	variable, ok := isExpr.Expr.(ast.VariableExpression)
+	if !ok {
+		return env, nil
+	}
+
+	narrowedType, err := parseRuntimeType(isExpr.Expected, env)
+	if err != nil {
+		return nil, exception.Annotate(err, isExpr)
+	}
+
+	narrowedEnv := environment.NewEnvironment(env)
+	narrowedEnv.Narrow(variable.Name.Lexeme, narrowedType)
+
+	return narrowedEnv, nil
+}
+
+func evaluateIf(condition ast.Expression, thenBranch, elseBranch ast.Statement, env *environment.Environment, node globals.Node) (interface{}, error) {
+	value, err := Evaluate(condition, env)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCondition, ok := value.(bool)
+	if !ok {
+		return nil, exception.NewUmbraError("RT062", node, runtimeTypeOf(value))
+	}
+
+	if parsedCondition {
+		branchEnv, err := narrow(condition, env)
+		if err != nil {
+			return nil, err
+		}
+
+		return evaluateBlock(thenBranch, branchEnv)
+	}
+
+	if elseBranch != nil {
+		return evaluateBlock(elseBranch, env)
+	}
+
+	return nil, nil
 }
@@ -0,0 +1,107 @@
+package interpreter
+
+import (
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/types"
+)
+
+type iterator func(yield func(key interface{}, value interface{}) (bool, error)) error
+
+func newIterator(value interface{}, node ast.Statement) (iterator, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		return func(yield func(interface{}, interface{}) (bool, error)) error {
+			for i, element := range v {
+				if next, err := yield(float64(i), element); !next || err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil
+	case string:
+		return func(yield func(interface{}, interface{}) (bool, error)) error {
+			for i, char := range []rune(v) {
+				if next, err := yield(float64(i), char); !next || err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil
+	case map[interface{}]interface{}:
+		return func(yield func(interface{}, interface{}) (bool, error)) error {
+			for key, element := range v {
+				if next, err := yield(key, element); !next || err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil
+	case float64:
+		return newIterator(Range{Start: 0, Stop: v, Step: 1}, node)
+	case *EnumType:
+		return func(yield func(interface{}, interface{}) (bool, error)) error {
+			for i, member := range v.OrderedMembers() {
+				if next, err := yield(float64(i), member); !next || err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil
+	case Range:
+		return func(yield func(interface{}, interface{}) (bool, error)) error {
+			for i := 0; i < v.Len(); i++ {
+				element, _ := v.At(i)
+				if next, err := yield(float64(i), element); !next || err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil
+	}
+
+	return nil, exception.NewUmbraError("RT054", node, runtimeTypeOf(value))
+}
+
+func iterate(stmt ast.IterableForStatement, env *environment.Environment) error {
+	iterable, err := Evaluate(stmt.Iterable, env)
+	if err != nil {
+		return err
+	}
+
+	each, err := newIterator(iterable, stmt)
+	if err != nil {
+		return err
+	}
+
+	_, isHashmap := iterable.(map[interface{}]interface{})
+
+	return each(func(key interface{}, value interface{}) (bool, error) {
+		loopEnv := environment.NewEnvironment(env)
+		anyType := types.RuntimeType{Type: types.ANY, Nullable: true}
+
+		if stmt.Value.Lexeme != "" {
+			loopEnv.Create(stmt, stmt.Key.Lexeme, key, anyType, false, false)
+			loopEnv.Create(stmt, stmt.Value.Lexeme, value, anyType, false, false)
+		} else if isHashmap {
+			loopEnv.Create(stmt, stmt.Key.Lexeme, key, anyType, false, false)
+		} else {
+			loopEnv.Create(stmt, stmt.Key.Lexeme, value, anyType, false, false)
+		}
+
+		if err := Interpret(stmt.Body, loopEnv); err != nil {
+			if _, ok := err.(Break); ok {
+				return false, nil
+			}
+
+			if _, ok := err.(Continue); ok {
+				return true, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	})
+}
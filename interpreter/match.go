@@ -1,13 +1,27 @@
 package interpreter
 
-import "github.com/pmqueiroz/umbra/ast"
+import (
+	"strings"
+
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/types"
+)
 
 func checkMatch(pattern interface{}, expr interface{}) bool {
 	switch p := pattern.(type) {
 	case ast.EnumMember:
 		if e, ok := expr.(ast.EnumMember); ok {
 			// deep compare
-			return p.Name == e.Name && p.Signature == e.Signature
+			return p.Name == e.Name && p.Parent == e.Parent
+		}
+
+		return false
+	case nil, string, rune, float64, bool:
+		switch expr.(type) {
+		case nil, string, rune, float64, bool:
+			return p == expr
 		}
 
 		return false
@@ -15,3 +29,193 @@ func checkMatch(pattern interface{}, expr interface{}) bool {
 		return false
 	}
 }
+
+func isWildcard(pattern ast.Expression) bool {
+	variable, ok := pattern.(ast.VariableExpression)
+
+	return ok && variable.Name.Lexeme == "_"
+}
+
+func matchPattern(pattern ast.Expression, value interface{}, env *environment.Environment, armEnv *environment.Environment) (bool, error) {
+	if isWildcard(pattern) {
+		return true, nil
+	}
+
+	if call, ok := pattern.(ast.CallExpression); ok {
+		callee, err := Evaluate(call.Callee, env)
+		if err != nil {
+			return false, err
+		}
+
+		if member, ok := callee.(ast.EnumMember); ok {
+			if !checkMatch(member, value) {
+				return false, nil
+			}
+
+			arguments := value.(ast.EnumMember).Arguments
+
+			if len(call.Arguments) != len(arguments) {
+				return false, exception.NewUmbraError("RT050", pattern, member.Name, len(arguments), len(call.Arguments))
+			}
+
+			for i, argument := range call.Arguments {
+				if isWildcard(argument) {
+					continue
+				}
+
+				if binding, ok := argument.(ast.VariableExpression); ok {
+					if !armEnv.Bind(binding.Name.Lexeme, arguments[i].Value, types.RuntimeType{Type: arguments[i].Type}) {
+						return false, exception.NewUmbraError("RT082", argument, binding.Name.Lexeme)
+					}
+					continue
+				}
+
+				expected, err := Evaluate(argument, env)
+				if err != nil {
+					return false, err
+				}
+
+				if !checkMatch(expected, arguments[i].Value) {
+					return false, nil
+				}
+			}
+
+			return true, nil
+		}
+	}
+
+	expected, err := Evaluate(pattern, env)
+	if err != nil {
+		return false, err
+	}
+
+	return checkMatch(expected, value), nil
+}
+
+func checkExhaustiveness(expr ast.MatchExpression, member ast.EnumMember, env *environment.Environment) error {
+	enum, ok := member.Parent.(*EnumType)
+	if !ok {
+		return nil
+	}
+
+	covered := make(map[string]bool)
+
+	for _, matchCase := range expr.Cases {
+		if matchCase.Guard != nil {
+			continue
+		}
+
+		if isWildcard(matchCase.Expression) {
+			return nil
+		}
+
+		pattern := matchCase.Expression
+
+		if call, ok := pattern.(ast.CallExpression); ok {
+			irrefutable := true
+			for _, argument := range call.Arguments {
+				if _, ok := argument.(ast.VariableExpression); !ok {
+					irrefutable = false
+				}
+			}
+
+			if !irrefutable {
+				continue
+			}
+
+			pattern = call.Callee
+		}
+
+		value, err := Evaluate(pattern, env)
+		if err != nil {
+			return err
+		}
+
+		if patternMember, ok := value.(ast.EnumMember); ok && patternMember.Parent == member.Parent {
+			covered[patternMember.Name] = true
+		}
+	}
+
+	var missing []string
+	for _, name := range enum.Order {
+		if !covered[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return exception.NewUmbraError("RT052", expr, enum.Name.Lexeme, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func evaluateMatch(expr ast.MatchExpression, env *environment.Environment) (interface{}, error) {
+	value, err := Evaluate(expr.Expression, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if member, ok := value.(ast.EnumMember); ok {
+		if err := checkExhaustiveness(expr, member, env); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, matchCase := range expr.Cases {
+		armEnv := environment.NewEnvironment(env)
+
+		matched, err := matchPattern(matchCase.Expression, value, env, armEnv)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matched {
+			continue
+		}
+
+		if matchCase.Guard != nil {
+			guard, err := Evaluate(matchCase.Guard, armEnv)
+			if err != nil {
+				return nil, err
+			}
+
+			parsedGuard, ok := guard.(bool)
+			if !ok {
+				return nil, exception.NewUmbraError("RT051", matchCase.Guard, runtimeTypeOf(guard))
+			}
+
+			if !parsedGuard {
+				continue
+			}
+		}
+
+		if matchCase.Body != nil {
+			return evaluateBlock(matchCase.Body, armEnv)
+		}
+
+		callback, err := processFunction(matchCase.Callback, env)
+		if err != nil {
+			return nil, err
+		}
+
+		var args []ast.EnumArgument
+		if member, ok := value.(ast.EnumMember); ok {
+			args = member.Arguments
+		}
+
+		result, err := processFunctionCall(callback, args, env)
+		if returnValue, ok := err.(Return); ok {
+			return returnValue.Value, nil
+		}
+
+		return result, err
+	}
+
+	stringified, err := stringConversion(value, expr)
+	if err != nil {
+		stringified = string(runtimeTypeOf(value))
+	}
+
+	return nil, exception.NewUmbraError("RT053", expr, stringified)
+}
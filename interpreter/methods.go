@@ -0,0 +1,80 @@
+package interpreter
+
+import (
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+)
+
+type methodSet struct {
+	Methods    map[string]FunctionDeclaration
+	Interfaces map[*InterfaceType]bool
+}
+
+func newMethodSet() methodSet {
+	return methodSet{
+		Methods:    make(map[string]FunctionDeclaration),
+		Interfaces: make(map[*InterfaceType]bool),
+	}
+}
+
+func declareMethod(stmt ast.FunctionExpression, env *environment.Environment) error {
+	receiver, ok := env.Get(stmt.Receiver.Lexeme, true)
+	if !ok {
+		return exception.NewUmbraError("RT002", stmt, stmt.Receiver.Lexeme)
+	}
+
+	var set *methodSet
+
+	switch parent := receiver.Data.(type) {
+	case *StructType:
+		if _, ok := parent.Get(stmt.Name.Lexeme); ok {
+			return exception.NewUmbraError("RT046", stmt, stmt.Name.Lexeme, stmt.Receiver.Lexeme)
+		}
+		set = &parent.methodSet
+	case *EnumType:
+		set = &parent.methodSet
+	default:
+		return exception.NewUmbraError("RT045", stmt, stmt.Name.Lexeme, stmt.Receiver.Lexeme)
+	}
+
+	if _, exists := set.Methods[stmt.Name.Lexeme]; exists {
+		return exception.NewUmbraError("RT046", stmt, stmt.Name.Lexeme, stmt.Receiver.Lexeme)
+	}
+
+	fun, err := newFunctionDeclaration(stmt, env)
+	if err != nil {
+		return err
+	}
+
+	set.Methods[stmt.Name.Lexeme] = fun
+	return nil
+}
+
+func methodsOf(value interface{}) (*methodSet, bool) {
+	switch v := value.(type) {
+	case *StructInstance:
+		return &v.Parent.methodSet, true
+	case ast.EnumMember:
+		if enum, ok := v.Parent.(*EnumType); ok {
+			return &enum.methodSet, true
+		}
+	}
+
+	return nil, false
+}
+
+func getMethod(receiver interface{}, name string) (FunctionDeclaration, bool) {
+	set, ok := methodsOf(receiver)
+	if !ok {
+		return FunctionDeclaration{}, false
+	}
+
+	method, ok := set.Methods[name]
+	if !ok {
+		return FunctionDeclaration{}, false
+	}
+
+	method.This = receiver
+	return method, true
+}
@@ -60,7 +60,7 @@ func LoadBuiltInModule(path string, namespace *environment.Environment) error {
 }
 
 func LoadModule(path string) (Module, error) {
-	namespace := environment.NewEnvironment(nil)
+	namespace := NewGlobalEnvironment()
 
 	if len(path) >= 7 && path[:7] == "native/" {
 		err := LoadInternalModule(path[7:], namespace)
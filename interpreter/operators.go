@@ -0,0 +1,88 @@
+package interpreter
+
+import (
+	"math"
+
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/tokens"
+)
+
+func numericOperands(expr ast.BinaryExpression, left, right interface{}) (float64, float64, error) {
+	leftVal, leftOk := left.(float64)
+	rightVal, rightOk := right.(float64)
+
+	if !leftOk || !rightOk {
+		return 0, 0, exception.NewUmbraError("RT060", expr, expr.Operator.Lexeme, runtimeTypeOf(left), runtimeTypeOf(right))
+	}
+
+	return leftVal, rightVal, nil
+}
+
+func integralOperands(expr ast.BinaryExpression, left, right interface{}) (int64, int64, error) {
+	isIntegral := func(value interface{}) bool {
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number) && math.Abs(number) <= math.MaxInt64
+	}
+
+	if !isIntegral(left) || !isIntegral(right) {
+		return 0, 0, exception.NewUmbraError("RT058", expr, expr.Operator.Lexeme, describeOperand(left), describeOperand(right))
+	}
+
+	return int64(left.(float64)), int64(right.(float64)), nil
+}
+
+func describeOperand(value interface{}) string {
+	if number, ok := value.(float64); ok {
+		stringified, _ := stringConversion(number, nil)
+		return stringified
+	}
+
+	return string(runtimeTypeOf(value))
+}
+
+func evaluateArithmetic(expr ast.BinaryExpression, left, right interface{}) (interface{}, error) {
+	switch expr.Operator.Type {
+	case tokens.SLASH_SLASH, tokens.STAR_STAR:
+		leftVal, rightVal, err := numericOperands(expr, left, right)
+		if err != nil {
+			return nil, err
+		}
+
+		if expr.Operator.Type == tokens.STAR_STAR {
+			return math.Pow(leftVal, rightVal), nil
+		}
+
+		if rightVal == 0 {
+			return nil, exception.NewUmbraError("RT008", expr)
+		}
+
+		return math.Floor(leftVal / rightVal), nil
+	}
+
+	leftVal, rightVal, err := integralOperands(expr, left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr.Operator.Type {
+	case tokens.AMPERSAND:
+		return float64(leftVal & rightVal), nil
+	case tokens.PIPE:
+		return float64(leftVal | rightVal), nil
+	case tokens.CARET:
+		return float64(leftVal ^ rightVal), nil
+	case tokens.LESS_LESS, tokens.GREATER_GREATER:
+		if rightVal < 0 {
+			return nil, exception.NewUmbraError("RT059", expr, rightVal)
+		}
+
+		if expr.Operator.Type == tokens.LESS_LESS {
+			return float64(leftVal << rightVal), nil
+		}
+
+		return float64(leftVal >> rightVal), nil
+	}
+
+	return nil, exception.NewUmbraError("RT010", expr, expr.Operator.Lexeme)
+}
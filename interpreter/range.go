@@ -0,0 +1,167 @@
+package interpreter
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/globals"
+)
+
+const rangeEpsilon = 1e-9
+
+type Range struct {
+	Start     float64
+	Stop      float64
+	Step      float64
+	Inclusive bool
+}
+
+func (r Range) Len() int {
+	return int(r.length())
+}
+
+func (r Range) length() float64 {
+	span := (r.Stop - r.Start) / r.Step
+
+	var length float64
+	if r.Inclusive {
+		length = math.Floor(span+rangeEpsilon) + 1
+	} else {
+		length = math.Ceil(span - rangeEpsilon)
+	}
+
+	if length < 0 {
+		return 0
+	}
+
+	return length
+}
+
+func (r Range) At(index int) (float64, bool) {
+	if index < 0 || index >= r.Len() {
+		return 0, false
+	}
+
+	return r.Start + float64(index)*r.Step, true
+}
+
+func (r Range) Contains(value float64) bool {
+	position := (value - r.Start) / r.Step
+	index := math.Round(position)
+
+	if math.Abs(position-index) > rangeEpsilon {
+		return false
+	}
+
+	return index >= 0 && index < r.length()
+}
+
+func (r Range) String() string {
+	format := func(n float64) string {
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+
+	operator := ".."
+	if r.Inclusive {
+		operator = "..="
+	}
+
+	result := format(r.Start) + operator + format(r.Stop)
+
+	if r.Step != 1 {
+		result += " step " + format(r.Step)
+	}
+
+	return result
+}
+
+func newRange(start, stop, step interface{}, inclusive bool, node globals.Node) (Range, error) {
+	bounds := []interface{}{start, stop, step}
+	names := []string{"start", "stop", "step"}
+	parsed := make([]float64, len(bounds))
+
+	for i, bound := range bounds {
+		value, ok := bound.(float64)
+		if !ok {
+			return Range{}, exception.NewUmbraError("RT055", node, names[i], runtimeTypeOf(bound))
+		}
+
+		parsed[i] = value
+	}
+
+	if parsed[2] == 0 {
+		return Range{}, exception.NewUmbraError("RT056", node)
+	}
+
+	r := Range{Start: parsed[0], Stop: parsed[1], Step: parsed[2], Inclusive: inclusive}
+
+	if length := r.length(); math.IsNaN(length) || length >= math.MaxInt {
+		return Range{}, exception.NewUmbraError("RT083", node, math.MaxInt)
+	}
+
+	return r, nil
+}
+
+func evaluateRange(expr ast.RangeExpression, env *environment.Environment) (interface{}, error) {
+	start, err := Evaluate(expr.Start, env)
+	if err != nil {
+		return nil, err
+	}
+
+	stop, err := Evaluate(expr.Stop, env)
+	if err != nil {
+		return nil, err
+	}
+
+	var step interface{}
+
+	if expr.Step != nil {
+		step, err = Evaluate(expr.Step, env)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// descending bounds count down unless told otherwise
+		step = 1.0
+		if s, ok := start.(float64); ok {
+			if e, ok := stop.(float64); ok && s > e {
+				step = -1.0
+			}
+		}
+	}
+
+	return newRange(start, stop, step, expr.Inclusive(), expr)
+}
+
+func contains(collection interface{}, value interface{}, node globals.Node) (bool, error) {
+	switch c := collection.(type) {
+	case Range:
+		number, ok := value.(float64)
+		return ok && c.Contains(number), nil
+	case []interface{}:
+		for _, element := range c {
+			if reflect.DeepEqual(element, value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case string:
+		switch v := value.(type) {
+		case string:
+			return strings.Contains(c, v), nil
+		case rune:
+			return strings.ContainsRune(c, v), nil
+		}
+		return false, nil
+	case map[interface{}]interface{}:
+		_, ok := c[value]
+		return ok, nil
+	}
+
+	return false, exception.NewUmbraError("RT057", node, runtimeTypeOf(collection))
+}
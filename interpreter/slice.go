@@ -0,0 +1,119 @@
+package interpreter
+
+import (
+	"math"
+
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/globals"
+)
+
+func normalizeIndex(index interface{}, length int, node globals.Node) (int, error) {
+	idx, ok := index.(float64)
+	if !ok || idx != math.Trunc(idx) {
+		return 0, exception.NewUmbraError("RT003", node, index)
+	}
+
+	if idx < 0 {
+		return int(idx) + length, nil
+	}
+
+	return int(idx), nil
+}
+
+func indexSequence(object interface{}, index interface{}, node globals.Node) (interface{}, error) {
+	switch obj := object.(type) {
+	case string:
+		runes := []rune(obj)
+
+		idx, err := normalizeIndex(index, len(runes), node)
+		if err != nil {
+			return nil, err
+		}
+
+		if idx < 0 || idx >= len(runes) {
+			return nil, exception.NewUmbraError("RT004", node, index)
+		}
+
+		return runes[idx], nil
+	default:
+		idx, err := normalizeIndex(index, getLength(obj), node)
+		if err != nil {
+			return nil, err
+		}
+
+		if idx < 0 || idx >= getLength(obj) {
+			return nil, exception.NewUmbraError("RT004", node, index)
+		}
+
+		return getElementAt(obj, idx), nil
+	}
+}
+
+func evaluateSlice(expr ast.SliceExpression, env *environment.Environment) (interface{}, error) {
+	object, err := Evaluate(expr.Object, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if object == nil && expr.Optional {
+		return nil, ShortCircuit{}
+	}
+
+	var length int
+
+	switch obj := object.(type) {
+	case string:
+		length = len([]rune(obj))
+	case []interface{}:
+		length = len(obj)
+	default:
+		return nil, exception.NewUmbraError("RT071", expr, runtimeTypeOf(object))
+	}
+
+	bound := func(bound ast.Expression, fallback int) (int, error) {
+		if bound == nil {
+			return fallback, nil
+		}
+
+		value, err := Evaluate(bound, env)
+		if err != nil {
+			return 0, err
+		}
+
+		idx, err := normalizeIndex(value, length, bound)
+		if err != nil {
+			return 0, err
+		}
+
+		if idx < 0 || idx > length {
+			return 0, exception.NewUmbraError("RT004", bound, value)
+		}
+
+		return idx, nil
+	}
+
+	start, err := bound(expr.Start, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := bound(expr.End, length)
+	if err != nil {
+		return nil, err
+	}
+
+	if start > end {
+		return nil, exception.NewUmbraError("RT004", expr, start)
+	}
+
+	switch obj := object.(type) {
+	case string:
+		return string([]rune(obj)[start:end]), nil
+	default:
+		result := make([]interface{}, end-start)
+		copy(result, obj.([]interface{})[start:end])
+		return result, nil
+	}
+}
@@ -0,0 +1,82 @@
+package interpreter
+
+import (
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+)
+
+func evaluateSpreadable(expressions []ast.Expression, env *environment.Environment) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+
+	for _, expression := range expressions {
+		spread, ok := expression.(ast.SpreadExpression)
+		if !ok {
+			value, err := Evaluate(expression, env)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, value)
+			continue
+		}
+
+		value, err := Evaluate(spread.Expression, env)
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := value.(type) {
+		case []interface{}:
+			result = append(result, v...)
+		case Range:
+			for i := 0; i < v.Len(); i++ {
+				element, _ := v.At(i)
+				result = append(result, element)
+			}
+		default:
+			return nil, exception.NewUmbraError("RT063", spread, runtimeTypeOf(value), "an <arr> or a <range>")
+		}
+	}
+
+	return result, nil
+}
+
+func evaluateHashmap(expr ast.HashmapExpression, env *environment.Environment) (map[interface{}]interface{}, error) {
+	hashmap := make(map[interface{}]interface{})
+
+	for _, pair := range expr.Pairs {
+		if pair.Key == nil {
+			spread := pair.Value.(ast.SpreadExpression)
+
+			value, err := Evaluate(spread.Expression, env)
+			if err != nil {
+				return nil, err
+			}
+
+			entries, ok := value.(map[interface{}]interface{})
+			if !ok {
+				return nil, exception.NewUmbraError("RT063", spread, runtimeTypeOf(value), "a <hashmap>")
+			}
+
+			for key, entry := range entries {
+				hashmap[key] = entry
+			}
+			continue
+		}
+
+		key, err := Evaluate(pair.Key, env)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := Evaluate(pair.Value, env)
+		if err != nil {
+			return nil, err
+		}
+
+		hashmap[key] = value
+	}
+
+	return hashmap, nil
+}
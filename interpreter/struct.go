@@ -0,0 +1,115 @@
+package interpreter
+
+import (
+	"strings"
+
+	"github.com/pmqueiroz/umbra/ast"
+	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/globals"
+	"github.com/pmqueiroz/umbra/types"
+)
+
+type StructType struct {
+	ast.StructStatement
+	methodSet
+	FieldTypes map[string]types.RuntimeType
+}
+
+func newStructType(stmt ast.StructStatement) *StructType {
+	return &StructType{
+		StructStatement: stmt,
+		methodSet:       newMethodSet(),
+		FieldTypes:      make(map[string]types.RuntimeType),
+	}
+}
+
+func resolveStructFields(parent *StructType, env *environment.Environment) error {
+	for _, field := range parent.Fields {
+		fieldType, err := parseRuntimeType(field.Type, env)
+		if err != nil {
+			return err
+		}
+
+		parent.FieldTypes[field.Name.Lexeme] = fieldType
+	}
+
+	return nil
+}
+
+type StructInstance struct {
+	Parent *StructType
+	Fields map[string]interface{}
+}
+
+func (s *StructInstance) String() string {
+	fields := []string{}
+
+	for _, field := range s.Parent.Fields {
+		value := s.Fields[field.Name.Lexeme]
+
+		if value == nil {
+			fields = append(fields, field.Name.Lexeme+": null")
+			continue
+		}
+
+		stringified, err := stringConversion(value, nil)
+		if err != nil {
+			stringified = string(runtimeTypeOf(value))
+		}
+
+		fields = append(fields, field.Name.Lexeme+": "+stringified)
+	}
+
+	return s.Parent.Name.Lexeme + " { " + strings.Join(fields, ", ") + " }"
+}
+
+func (s *StructInstance) Get(name string, node globals.Node) (interface{}, error) {
+	value, ok := s.Fields[name]
+	if !ok {
+		return nil, exception.NewUmbraError("RT043", node, s.Parent.Name.Lexeme, name)
+	}
+
+	return value, nil
+}
+
+func (s *StructInstance) Set(name string, value interface{}, node globals.Node) error {
+	fieldType, ok := s.Parent.FieldTypes[name]
+	if !ok {
+		return exception.NewUmbraError("RT043", node, s.Parent.Name.Lexeme, name)
+	}
+
+	if err := checkRuntimeType(fieldType, value, node); err != nil {
+		return err
+	}
+
+	s.Fields[name] = value
+	return nil
+}
+
+func instantiateStruct(parent *StructType, args []interface{}, node globals.Node) (*StructInstance, error) {
+	if len(args) > len(parent.Fields) {
+		return nil, exception.NewUmbraError("RT044", node, parent.Name.Lexeme, len(parent.Fields), len(args))
+	}
+
+	instance := &StructInstance{
+		Parent: parent,
+		Fields: make(map[string]interface{}),
+	}
+
+	for i, field := range parent.Fields {
+		var value interface{}
+
+		if i < len(args) {
+			value = args[i]
+		} else if !field.Type.Nullable {
+			return nil, exception.NewUmbraError("RT044", node, parent.Name.Lexeme, len(parent.Fields), len(args))
+		}
+
+		if err := instance.Set(field.Name.Lexeme, value, node); err != nil {
+			return nil, err
+		}
+	}
+
+	return instance, nil
+}
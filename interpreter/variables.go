@@ -3,27 +3,71 @@ package interpreter
 import (
 	"github.com/pmqueiroz/umbra/ast"
 	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/native"
 	"github.com/pmqueiroz/umbra/tokens"
 	"github.com/pmqueiroz/umbra/types"
 )
 
 func resolveVarDeclaration(stmt ast.VarStatement, value interface{}, env *environment.Environment) error {
-	err := checkDeclarationType(stmt.Type, stmt.Nullable, value, env, stmt)
+	if stmt.Type.Inferred() {
+		varType, err := inferRuntimeType(value, stmt)
+
+		// a copied variable keeps its type, as narrowed by `is` where it applies
+		if source, ok := stmt.Initializer.(ast.VariableExpression); ok {
+			variable, ok := env.Get(source.Name.Lexeme, true)
+			if ok && variable.DataType.Type != types.ANY && checkRuntimeType(variable.DataType, value, stmt) == nil {
+				varType, err = variable.DataType, nil
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		env.Create(stmt, stmt.Name.Lexeme, value, varType, false, stmt.Mutable)
+		return nil
+	}
+
+	varType, err := parseRuntimeType(stmt.Type, env)
 
 	if err != nil {
 		return err
 	}
 
-	varType, err := types.ParseTokenType(stmt.Type.Type)
+	err = checkRuntimeType(varType, value, stmt)
 
 	if err != nil {
 		return err
 	}
 
-	env.Create(stmt, stmt.Name.Lexeme, value, varType, stmt.Nullable, false, stmt.Mutable)
+	env.Create(stmt, stmt.Name.Lexeme, value, varType, false, stmt.Mutable)
 	return nil
 }
 
+// collections are left unparameterized so they can hold any element later on
+func inferRuntimeType(value interface{}, stmt ast.VarStatement) (types.RuntimeType, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.RuntimeType{}, exception.NewUmbraError("RT075", stmt, stmt.Name.Lexeme)
+	case *StructInstance:
+		return types.RuntimeType{Type: types.STRUCT, Parent: v.Parent}, nil
+	case ast.EnumMember:
+		return types.RuntimeType{Type: types.ENUM, Parent: v.Parent}, nil
+	case FunctionDeclaration:
+		return functionRuntimeType(v)
+	case native.InternalModuleFn:
+		return types.RuntimeType{Type: types.FUN}, nil
+	}
+
+	inferred, err := types.ParseUmbraType(value)
+	if err != nil {
+		return types.RuntimeType{}, exception.Annotate(err, stmt)
+	}
+
+	return types.RuntimeType{Type: inferred}, nil
+}
+
 func zero(t tokens.TokenType) interface{} {
 	switch t {
 	case tokens.STR_TYPE:
@@ -40,6 +84,8 @@ func zero(t tokens.TokenType) interface{} {
 		return make([]interface{}, 0)
 	case tokens.FUN_TYPE:
 		return FunctionDeclaration{}
+	case tokens.RANGE:
+		return Range{Step: 1}
 	default: // any, void
 		return nil
 	}
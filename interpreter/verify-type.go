@@ -1,28 +1,460 @@
 package interpreter
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pmqueiroz/umbra/ast"
 	"github.com/pmqueiroz/umbra/environment"
 	"github.com/pmqueiroz/umbra/exception"
+	"github.com/pmqueiroz/umbra/globals"
+	"github.com/pmqueiroz/umbra/native"
 	"github.com/pmqueiroz/umbra/tokens"
 	"github.com/pmqueiroz/umbra/types"
 )
 
-func parseRuntimeType(t tokens.Token, env *environment.Environment) (types.UmbraType, ast.EnumStatement, error) {
-	switch t.Type {
+func parseRuntimeType(t ast.TypeAnnotation, env *environment.Environment) (types.RuntimeType, error) {
+	if len(t.Members) > 0 {
+		union := types.RuntimeType{Type: types.UNION, Nullable: t.Nullable}
+
+		for _, member := range t.Members {
+			parsedMember, err := parseRuntimeType(member, env)
+			if err != nil {
+				return types.RuntimeType{}, err
+			}
+
+			union.Members = append(union.Members, parsedMember)
+			union.Nullable = union.Nullable || parsedMember.Nullable
+		}
+
+		return union, nil
+	}
+
+	var arguments []types.RuntimeType
+
+	for _, argument := range t.Arguments {
+		parsedArgument, err := parseRuntimeType(argument, env)
+		if err != nil {
+			return types.RuntimeType{}, err
+		}
+
+		arguments = append(arguments, parsedArgument)
+	}
+
+	switch t.Token.Type {
 	case tokens.IDENTIFIER:
-		value, ok := env.Get(t.Lexeme, true)
+		value, ok := env.Get(t.Token.Lexeme, true)
 		if !ok {
-			return types.UNKNOWN, ast.EnumStatement{}, exception.NewUmbraError("RT002", nil, t.Lexeme)
+			return types.RuntimeType{}, exception.NewUmbraError("RT002", nil, t.Token.Lexeme)
+		}
+
+		switch parent := value.Data.(type) {
+		case *EnumType:
+			return types.RuntimeType{Type: types.ENUM, Parent: parent, Nullable: t.Nullable}, nil
+		case *StructType:
+			return types.RuntimeType{Type: types.STRUCT, Parent: parent, Nullable: t.Nullable}, nil
+		case *InterfaceType:
+			return types.RuntimeType{Type: types.INTERFACE, Parent: parent, Nullable: t.Nullable}, nil
+		case ast.TypeAliasStatement:
+			aliased := value.DataType
+			aliased.Nullable = aliased.Nullable || t.Nullable
+
+			return aliased, nil
+		}
+
+		return types.RuntimeType{}, exception.NewUmbraError("TY002", nil, t.Token.Lexeme)
+	default:
+		parsedType, err := types.ParseTokenType(t.Token.Type)
+		if err != nil {
+			return types.RuntimeType{}, err
+		}
+
+		parsed := types.RuntimeType{Type: parsedType, Arguments: arguments, Nullable: t.Nullable}
+
+		if t.Return != nil {
+			returnType, err := parseRuntimeType(*t.Return, env)
+			if err != nil {
+				return types.RuntimeType{}, err
+			}
+
+			parsed.Return = &returnType
+		}
+
+		return parsed, nil
+	}
+}
+
+func checkRuntimeType(t types.RuntimeType, value interface{}, node globals.Node) error {
+	return checkRuntimeTypeAt(t, value, node, "")
+}
+
+func checkRuntimeTypeAt(t types.RuntimeType, value interface{}, node globals.Node, path string) error {
+	if value == nil && t.Nullable {
+		return nil
+	}
+
+	mismatch := func() error {
+		if path != "" {
+			return exception.NewUmbraError("TY003", node, formatRuntimeType(t), runtimeTypeOf(value), path)
+		}
+
+		return exception.NewUmbraError("TY001", node, formatRuntimeType(t), runtimeTypeOf(value))
+	}
+
+	switch t.Type {
+	case types.ANY:
+		return nil
+	case types.UNION:
+		for _, member := range t.Members {
+			if checkRuntimeTypeAt(member, value, node, path) == nil {
+				return nil
+			}
+		}
+
+		return mismatch()
+	case types.INTERFACE:
+		iface, _ := t.Parent.(*InterfaceType)
+		if implements(value, iface) {
+			return nil
+		}
+
+		return mismatch()
+	case types.ENUM:
+		if member, ok := value.(ast.EnumMember); ok && member.Parent == t.Parent {
+			return nil
+		}
+
+		return mismatch()
+	case types.STRUCT:
+		if instance, ok := value.(*StructInstance); ok && globals.Node(instance.Parent) == t.Parent {
+			return nil
+		}
+
+		return mismatch()
+	case types.FUN:
+		switch fn := value.(type) {
+		case FunctionDeclaration:
+			if t.Return == nil || fn.Itself == nil || matchesSignature(t, fn) {
+				return nil
+			}
+		case native.InternalModuleFn:
+			// native functions carry no signature, any function type accepts them
+			return nil
+		}
+
+		return mismatch()
+	}
+
+	switch v := value.(type) {
+	case *StructInstance, ast.EnumMember:
+		return mismatch()
+	case []interface{}:
+		if t.Type != types.ARR {
+			return mismatch()
+		}
+
+		if len(t.Arguments) == 1 {
+			for i, element := range v {
+				if err := checkRuntimeTypeAt(t.Arguments[0], element, node, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	case map[interface{}]interface{}:
+		if t.Type != types.HASHMAP {
+			return mismatch()
+		}
+
+		if len(t.Arguments) == 2 {
+			for key, element := range v {
+				elementPath := fmt.Sprintf("%s[%#v]", path, key)
+
+				if err := checkRuntimeTypeAt(t.Arguments[0], key, node, elementPath); err != nil {
+					return err
+				}
+
+				if err := checkRuntimeTypeAt(t.Arguments[1], element, node, elementPath); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := types.CheckPrimitiveType(t.Type, value, t.Nullable, node); err != nil {
+		if path != "" || len(t.Arguments) > 0 {
+			return mismatch()
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func formatRuntimeType(t types.RuntimeType) string {
+	return "<" + runtimeTypeName(t) + ">"
+}
+
+func runtimeTypeName(t types.RuntimeType) string {
+	if t.Type == types.UNION {
+		members := []string{}
+		nullable := false
+
+		for _, member := range t.Members {
+			members = append(members, runtimeTypeName(member))
+			nullable = nullable || member.Nullable
+		}
+
+		name := strings.Join(members, " | ")
+
+		// the union itself is nullable when an alias of it is marked with '?'
+		if t.Nullable && !nullable {
+			name = "(" + name + ")?"
+		}
+
+		return name
+	}
+
+	var name string
+
+	switch parent := t.Parent.(type) {
+	case *EnumType:
+		name = parent.Name.Lexeme
+	case *StructType:
+		name = parent.Name.Lexeme
+	case *InterfaceType:
+		name = parent.Name.Lexeme
+	default:
+		name = strings.TrimSuffix(strings.TrimPrefix(string(t.Type), "<"), ">")
+	}
+
+	if t.Return != nil {
+		params := []string{}
+		for _, param := range t.Arguments {
+			params = append(params, runtimeTypeName(param))
+		}
+
+		name += "(" + strings.Join(params, ", ") + ") " + runtimeTypeName(*t.Return)
+	} else if len(t.Arguments) > 0 {
+		arguments := []string{}
+		for _, argument := range t.Arguments {
+			arguments = append(arguments, runtimeTypeName(argument))
+		}
+
+		name += "<" + strings.Join(arguments, ", ") + ">"
+	}
+
+	if t.Nullable {
+		name += "?"
+	}
+
+	return name
+}
+
+func runtimeTypeOf(value interface{}) types.UmbraType {
+	switch v := value.(type) {
+	case *StructInstance:
+		return types.UmbraType("<" + v.Parent.Name.Lexeme + ">")
+	case *StructType:
+		return types.UmbraType("<" + v.Name.Lexeme + ">")
+	case *EnumType:
+		return types.UmbraType("<" + v.Name.Lexeme + ">")
+	case *InterfaceType:
+		return types.UmbraType("<" + v.Name.Lexeme + ">")
+	case ast.TypeAliasStatement:
+		return types.UmbraType("<" + v.Name.Lexeme + ">")
+	case ast.EnumMember:
+		if enum, ok := v.Parent.(*EnumType); ok {
+			return types.UmbraType("<" + enum.Name.Lexeme + ">")
+		}
+
+		return types.ENUM
+	case FunctionDeclaration:
+		if v.Itself == nil {
+			return types.FUN
 		}
 
-		if enum, ok := value.Data.(ast.EnumStatement); ok {
-			return types.ENUM, enum, nil
+		params := []string{}
+		for _, param := range v.Itself.Params {
+			reference := param.Type.Reference()
+			if param.Variadic {
+				reference = "..." + reference
+			}
+
+			params = append(params, reference)
 		}
 
-		return types.UNKNOWN, ast.EnumStatement{}, exception.NewUmbraError("TY002", nil, t.Lexeme)
+		return types.UmbraType("<fun(" + strings.Join(params, ", ") + ") " + v.Itself.ReturnType.Reference() + ">")
+	case native.InternalModuleFn:
+		return types.FUN
 	default:
-		t, e := types.ParseTokenType(t.Type)
-		return t, ast.EnumStatement{}, e
+		return types.SafeParseUmbraType(value)
+	}
+}
+
+// parameters are checked contravariantly and the return type covariantly
+func matchesSignature(signature types.RuntimeType, fn FunctionDeclaration) bool {
+	params := fn.Itself.Params
+	required := 0
+	variadic := false
+
+	for _, param := range params {
+		if param.Variadic {
+			variadic = true
+		} else if param.Default == nil {
+			required++
+		}
+	}
+
+	if len(signature.Arguments) < required || (!variadic && len(signature.Arguments) > len(params)) {
+		return false
+	}
+
+	for i, expected := range signature.Arguments {
+		param := params[min(i, len(params)-1)]
+
+		paramType, err := parseRuntimeType(param.Type, fn.Environment)
+		if err != nil || !isAssignable(expected, paramType) {
+			return false
+		}
+	}
+
+	// callers of a void signature discard whatever is returned
+	if signature.Return.Type == types.VOID {
+		return true
+	}
+
+	return isAssignable(fn.ReturnType, *signature.Return)
+}
+
+func isAssignable(from types.RuntimeType, to types.RuntimeType) bool {
+	if to.Type == types.ANY {
+		return true
+	}
+
+	if from.Type == types.UNION {
+		for _, member := range from.Members {
+			if !isAssignable(member, to) {
+				return false
+			}
+		}
+
+		return !from.Nullable || to.Nullable
+	}
+
+	if to.Type == types.UNION {
+		if from.Nullable && !to.Nullable {
+			return false
+		}
+
+		for _, member := range to.Members {
+			member.Nullable = member.Nullable || from.Nullable
+			if isAssignable(from, member) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if from.Nullable && !to.Nullable {
+		return false
+	}
+
+	if to.Type == types.INTERFACE {
+		iface, _ := to.Parent.(*InterfaceType)
+
+		switch parent := from.Parent.(type) {
+		case *StructType:
+			return parent.Interfaces[iface]
+		case *EnumType:
+			return parent.Interfaces[iface]
+		case *InterfaceType:
+			return parent == iface
+		}
+
+		return false
+	}
+
+	if from.Type != to.Type {
+		return false
 	}
+
+	switch to.Type {
+	case types.ENUM, types.STRUCT:
+		return from.Parent == to.Parent
+	case types.FUN:
+		if to.Return == nil {
+			return true
+		}
+
+		if from.Return == nil || len(from.Arguments) != len(to.Arguments) {
+			return false
+		}
+
+		for i := range to.Arguments {
+			if !isAssignable(to.Arguments[i], from.Arguments[i]) {
+				return false
+			}
+		}
+
+		return to.Return.Type == types.VOID || isAssignable(*from.Return, *to.Return)
+	}
+
+	if len(from.Arguments) != len(to.Arguments) {
+		return true
+	}
+
+	for i := range to.Arguments {
+		if !isAssignable(from.Arguments[i], to.Arguments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func resolveTargetType(target ast.Expression, env *environment.Environment) (types.RuntimeType, bool) {
+	switch t := target.(type) {
+	case ast.GroupingExpression:
+		return resolveTargetType(t.Expression, env)
+	case ast.VariableExpression:
+		variable, ok := env.GetDeclared(t.Name.Lexeme, true)
+		if !ok {
+			return types.RuntimeType{}, false
+		}
+
+		return variable.DataType, true
+	case ast.MemberExpression:
+		parent, ok := resolveTargetType(t.Object, env)
+		if !ok {
+			return types.RuntimeType{}, false
+		}
+
+		switch parent.Type {
+		case types.ARR:
+			if len(parent.Arguments) == 1 {
+				return parent.Arguments[0], true
+			}
+		case types.HASHMAP:
+			if len(parent.Arguments) == 2 {
+				return parent.Arguments[1], true
+			}
+		case types.STRUCT:
+			parentStruct, isStruct := parent.Parent.(*StructType)
+			property, ok := t.Property.(ast.VariableExpression)
+			if t.Computed || !ok || !isStruct {
+				return types.RuntimeType{}, false
+			}
+
+			fieldType, ok := parentStruct.FieldTypes[property.Name.Lexeme]
+			return fieldType, ok
+		}
+	}
+
+	return types.RuntimeType{}, false
 }
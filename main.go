@@ -67,9 +67,9 @@ func main() {
 			fmt.Printf("%s\n", err.Error())
 		}
 
-		env := environment.NewEnvironment(nil)
+		env := interpreter.NewGlobalEnvironment()
 
-		env.Create(nil, "__FILE__", __FILE__, types.STR, false, false, false)
+		env.Create(nil, "__FILE__", __FILE__, types.RuntimeType{Type: types.STR}, false, false)
 
 		runErr := run(content, RunOptions{
 			Options: args.Options,
@@ -77,11 +77,11 @@ func main() {
 		})
 
 		if runErr != nil {
-			fmt.Println(err)
+			fmt.Println(runErr)
 			os.Exit(1)
 		}
 	} else {
-		cli.Repl(func(content string, env *environment.Environment) {
+		cli.Repl(interpreter.NewGlobalEnvironment(), func(content string, env *environment.Environment) {
 			runErr := run(content, RunOptions{
 				Options: args.Options,
 				Env:     env,
@@ -18,9 +18,17 @@ func keys(args []interface{}) (interface{}, error) {
 	return keys, nil
 }
 
+func get(args []interface{}) (interface{}, error) {
+	hashmap := args[0].(map[interface{}]interface{})
+	value, ok := hashmap[args[1]]
+
+	return Option{Value: value, Present: ok}, nil
+}
+
 var HashmapModule = InternalModule{
 	symbols: map[string]InternalModuleFn{
 		"delete": del,
 		"keys":   keys,
+		"get":    get,
 	},
 }
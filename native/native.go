@@ -2,17 +2,28 @@ package native
 
 import (
 	"github.com/pmqueiroz/umbra/environment"
+	"github.com/pmqueiroz/umbra/types"
 )
 
 type InternalModuleFn func([]interface{}) (interface{}, error)
 
+type Result struct {
+	Value interface{}
+	Err   error
+}
+
+type Option struct {
+	Value   interface{}
+	Present bool
+}
+
 type InternalModule struct {
 	symbols map[string]InternalModuleFn
 }
 
 func (m InternalModule) Register(namespace *environment.Environment) (ok bool) {
 	for name, symbol := range m.symbols {
-		createOk := namespace.Create(nil, name, symbol, "", false, true, false)
+		createOk := namespace.Create(nil, name, symbol, types.RuntimeType{Type: types.FUN}, true, false)
 		pubOk := namespace.MakePublic(name)
 
 		if !createOk || !pubOk {
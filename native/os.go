@@ -17,6 +17,12 @@ func readFile(args []interface{}) (interface{}, error) {
 	return string(content[:]), nil
 }
 
+func tryReadFile(args []interface{}) (interface{}, error) {
+	content, err := readFile(args)
+
+	return Result{Value: content, Err: err}, nil
+}
+
 func writeFile(args []interface{}) (interface{}, error) {
 	path := args[0].(string)
 	data := args[1].(string)
@@ -42,8 +48,9 @@ func deleteFile(args []interface{}) (interface{}, error) {
 
 var OsModule = InternalModule{
 	symbols: map[string]InternalModuleFn{
-		"readFile":   readFile,
-		"writeFile":  writeFile,
-		"deleteFile": deleteFile,
+		"readFile":    readFile,
+		"tryReadFile": tryReadFile,
+		"writeFile":   writeFile,
+		"deleteFile":  deleteFile,
 	},
 }
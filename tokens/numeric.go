@@ -0,0 +1,54 @@
+package tokens
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var numericLiterals = []struct {
+	pattern *regexp.Regexp
+	base    int
+}{
+	{regexp.MustCompile(`^0[xX][0-9a-fA-F](_?[0-9a-fA-F])*$`), 16},
+	{regexp.MustCompile(`^0[bB][01](_?[01])*$`), 2},
+	{regexp.MustCompile(`^0[oO][0-7](_?[0-7])*$`), 8},
+	{regexp.MustCompile(`^[0-9](_?[0-9])*(\.[0-9](_?[0-9])*)?([eE][+-]?[0-9](_?[0-9])*)?$`), 10},
+}
+
+func ParseNumeric(lexeme string) (float64, error) {
+	sign := 1.0
+	unsigned := lexeme
+
+	if strings.HasPrefix(unsigned, "-") {
+		sign = -1
+		unsigned = unsigned[1:]
+	} else if strings.HasPrefix(unsigned, "+") {
+		unsigned = unsigned[1:]
+	}
+
+	for _, literal := range numericLiterals {
+		if !literal.pattern.MatchString(unsigned) {
+			continue
+		}
+
+		digits := strings.ReplaceAll(unsigned, "_", "")
+
+		if literal.base == 10 {
+			value, err := strconv.ParseFloat(digits, 64)
+			return sign * value, err
+		}
+
+		value, err := strconv.ParseUint(digits[2:], literal.base, 64)
+		return sign * float64(value), err
+	}
+
+	// anything else strconv understands, like .5, Inf or hex floats
+	value, err := strconv.ParseFloat(lexeme, 64)
+	if err != nil {
+		return 0, errors.New("invalid numeric literal: " + lexeme)
+	}
+
+	return value, nil
+}
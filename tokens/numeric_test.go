@@ -0,0 +1,54 @@
+package tokens
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestParseNumeric(t *testing.T) {
+	var tests = []struct {
+		lexeme string
+		want   float64
+	}{
+		{"123", 123},
+		{"-4.5", -4.5},
+		{"0xFF", 255},
+		{"0b1010", 10},
+		{"0o17", 15},
+		{"-0x10", -16},
+		{"1_000_000", 1000000},
+		{"1.5e-3", 0.0015},
+		{".5", 0.5},
+		{"5.", 5},
+		{"Inf", math.Inf(1)},
+		{"0x1p4", 16},
+	}
+
+	for _, testCase := range tests {
+		testName := fmt.Sprintf("should parse %s as %v", testCase.lexeme, testCase.want)
+		t.Run(testName, func(t *testing.T) {
+			result, err := ParseNumeric(testCase.lexeme)
+			if err != nil {
+				t.Fatalf("return an unexpected error: %v", err)
+			}
+
+			if result != testCase.want {
+				t.Errorf("got %v, want %v", result, testCase.want)
+			}
+		})
+	}
+}
+
+func TestParseNumericErrors(t *testing.T) {
+	var tests = []string{"", "abc", "1__0", "0x", "12abc"}
+
+	for _, lexeme := range tests {
+		testName := fmt.Sprintf("should reject %q", lexeme)
+		t.Run(testName, func(t *testing.T) {
+			if _, err := ParseNumeric(lexeme); err == nil {
+				t.Error("expected an invalid numeric literal error")
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package tokens
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/pmqueiroz/umbra/exception"
 	"github.com/pmqueiroz/umbra/globals"
@@ -13,7 +16,11 @@ func isIdentifier(char rune) bool {
 }
 
 func isDigit(char rune) bool {
-	return unicode.IsDigit(char)
+	return char >= '0' && char <= '9'
+}
+
+func isHexDigit(char rune) bool {
+	return unicode.Is(unicode.ASCII_Hex_Digit, char)
 }
 
 func isAlphaNumeric(char rune) bool {
@@ -23,7 +30,7 @@ func isAlphaNumeric(char rune) bool {
 type Tokenizer struct {
 	tokens                               []Token
 	current, beginOfLexeme, line, column int
-	source                               string
+	source                               []rune
 }
 
 func (t *Tokenizer) isAtEnd() bool {
@@ -37,11 +44,11 @@ func (t *Tokenizer) add(token Token) {
 func (t *Tokenizer) advance() rune {
 	t.current++
 	t.column++
-	return []rune(t.source)[t.current-1]
+	return t.source[t.current-1]
 }
 
 func (t *Tokenizer) previous() rune {
-	return []rune(t.source)[t.current-1]
+	return t.source[t.current-1]
 }
 
 func (t *Tokenizer) peek() rune {
@@ -49,7 +56,7 @@ func (t *Tokenizer) peek() rune {
 		return '\000'
 	}
 
-	return []rune(t.source)[t.current]
+	return t.source[t.current]
 }
 
 func (t *Tokenizer) peekNext() rune {
@@ -57,7 +64,7 @@ func (t *Tokenizer) peekNext() rune {
 		return '\000'
 	}
 
-	return []rune(t.source)[t.current+1]
+	return t.source[t.current+1]
 }
 
 func (t *Tokenizer) match(expected rune) bool {
@@ -65,7 +72,7 @@ func (t *Tokenizer) match(expected rune) bool {
 		return false
 	}
 
-	if []rune(t.source)[t.current] != expected {
+	if t.source[t.current] != expected {
 		return false
 	}
 
@@ -74,7 +81,7 @@ func (t *Tokenizer) match(expected rune) bool {
 }
 
 func (t *Tokenizer) addNonLiteralToken(tokenType TokenType) {
-	lexeme := string([]rune(t.source)[t.beginOfLexeme:t.current])
+	lexeme := string(t.source[t.beginOfLexeme:t.current])
 	t.add(
 		Token{
 			Type:   tokenType,
@@ -95,39 +102,223 @@ func (t *Tokenizer) advanceLine() {
 	t.column = 0
 }
 
-func (t *Tokenizer) string() {
-	for (t.peek() != '"' || (t.peek() == '"' && t.previous() == '\\')) && !t.isAtEnd() {
+func (t *Tokenizer) addString(lexeme string) {
+	t.add(
+		Token{
+			Type:   STRING,
+			Lexeme: lexeme,
+			Loc: globals.Loc{
+				Line: t.line,
+				Range: globals.ColumnRange{
+					From: t.column - len(lexeme) + 1,
+					To:   t.column,
+				},
+			},
+		},
+	)
+}
+
+func (t *Tokenizer) interpolation(begin int) error {
+	depth := 0
+
+	for {
+		if t.isAtEnd() {
+			return exception.NewSyntaxError("Unterminated interpolation", t.line, t.column, string(t.source[begin:t.current]))
+		}
+
+		t.beginOfLexeme = t.current
+
+		if t.peek() == '}' && depth == 0 {
+			t.advance()
+			t.addNonLiteralToken(INTERPOLATION_END)
+			return nil
+		}
+
+		scanned := len(t.tokens)
+
+		if err := t.scan(); err != nil {
+			return err
+		}
+
+		if len(t.tokens) == scanned+1 {
+			switch t.tokens[scanned].Type {
+			case LEFT_BRACE:
+				depth++
+			case RIGHT_BRACE:
+				depth--
+			}
+		}
+	}
+}
+
+func (t *Tokenizer) escape() (rune, error) {
+	invalid := func() (rune, error) {
+		return 0, exception.NewSyntaxError("Invalid escape sequence", t.line, t.column, string(t.source[t.beginOfLexeme:t.current]))
+	}
+
+	if t.isAtEnd() {
+		return invalid()
+	}
+
+	t.beginOfLexeme = t.current - 1
+
+	switch t.advance() {
+	case 'n':
+		return '\n', nil
+	case 't':
+		return '\t', nil
+	case 'r':
+		return '\r', nil
+	case '0':
+		return 0, nil
+	case '\\':
+		return '\\', nil
+	case '"':
+		return '"', nil
+	case '\'':
+		return '\'', nil
+	case '$':
+		return '$', nil
+	case 'x':
+		digits := ""
+		for i := 0; i < 2 && isHexDigit(t.peek()); i++ {
+			digits += string(t.advance())
+		}
+
+		if len(digits) != 2 {
+			return invalid()
+		}
+
+		value, _ := strconv.ParseUint(digits, 16, 8)
+		return rune(value), nil
+	case 'u':
+		if !t.match('{') {
+			return invalid()
+		}
+
+		digits := ""
+		for isHexDigit(t.peek()) {
+			digits += string(t.advance())
+		}
+
+		if !t.match('}') || len(digits) == 0 || len(digits) > 6 {
+			return invalid()
+		}
+
+		value, _ := strconv.ParseUint(digits, 16, 32)
+		if !utf8.ValidRune(rune(value)) {
+			return invalid()
+		}
+
+		return rune(value), nil
+	default:
+		return invalid()
+	}
+}
+
+func (t *Tokenizer) closesString(multiline bool) bool {
+	if !multiline {
+		return t.peek() == '"'
+	}
+
+	return t.peek() == '"' && t.peekNext() == '"' && t.current+2 < len(t.source) && t.source[t.current+2] == '"'
+}
+
+// triple quoted strings drop the line break right after the opening quotes
+func (t *Tokenizer) string(raw bool) error {
+	begin := t.beginOfLexeme
+	multiline := t.peek() == '"' && t.peekNext() == '"'
+	interpolated := false
+	quote := Token{
+		Type:   TEMPLATE_START,
+		Lexeme: "\"",
+		Loc: globals.Loc{
+			Line:  t.line,
+			Range: globals.ColumnRange{From: t.column, To: t.column},
+		},
+	}
+
+	if multiline {
+		t.advance()
+		t.advance()
+
 		if t.peek() == '\n' {
 			t.advanceLine()
+			t.current++
 		}
+	}
 
-		t.advance()
+	var part strings.Builder
+
+	for !t.isAtEnd() && !t.closesString(multiline) {
+		if !raw && t.peek() == '$' && t.peekNext() == '{' {
+			if !interpolated {
+				t.add(quote)
+				interpolated = true
+			}
+
+			if part.Len() > 0 {
+				t.addString(part.String())
+				part.Reset()
+			}
+
+			t.beginOfLexeme = t.current
+			t.advance()
+			t.advance()
+			t.addNonLiteralToken(INTERPOLATION)
+
+			if err := t.interpolation(begin); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !raw && t.peek() == '\\' {
+			t.advance()
+
+			char, err := t.escape()
+			if err != nil {
+				return err
+			}
+
+			part.WriteRune(char)
+			continue
+		}
+
+		if t.peek() == '\n' {
+			t.advanceLine()
+		}
+
+		part.WriteRune(t.advance())
 	}
 
 	if t.isAtEnd() {
 		fmt.Println(
-			exception.NewSyntaxError("Unterminated string", t.line, t.column, string([]rune(t.source)[t.beginOfLexeme:t.current])),
+			exception.NewSyntaxError("Unterminated string", t.line, t.column, string(t.source[begin:t.current])),
 		)
-		return
+		return nil
 	}
 
 	t.advance()
 
-	lexeme := string([]rune(t.source)[t.beginOfLexeme+1 : t.current-1])
+	if multiline {
+		t.advance()
+		t.advance()
+	}
 
-	t.add(
-		Token{
-			Type:   STRING,
-			Lexeme: lexeme,
-			Loc: globals.Loc{
-				Line: t.line,
-				Range: globals.ColumnRange{
-					From: t.column - len(lexeme) + 1,
-					To:   t.column,
-				},
-			},
-		},
-	)
+	if !interpolated {
+		t.addString(part.String())
+		return nil
+	}
+
+	if part.Len() > 0 {
+		t.addString(part.String())
+	}
+
+	t.beginOfLexeme = t.current - 1
+	t.addNonLiteralToken(TEMPLATE_END)
+	return nil
 }
 
 func (t *Tokenizer) char() {
@@ -139,14 +330,14 @@ func (t *Tokenizer) char() {
 
 	if t.peek() != '\'' || t.isAtEnd() {
 		fmt.Println(
-			exception.NewSyntaxError("Unterminated char", t.line, t.column, string([]rune(t.source)[t.beginOfLexeme:t.current])),
+			exception.NewSyntaxError("Unterminated char", t.line, t.column, string(t.source[t.beginOfLexeme:t.current])),
 		)
 		return
 	}
 
 	t.advance()
 
-	lexeme := string([]rune(t.source)[t.beginOfLexeme+1 : t.current-1])
+	lexeme := string(t.source[t.beginOfLexeme+1 : t.current-1])
 
 	t.add(
 		Token{
@@ -163,20 +354,95 @@ func (t *Tokenizer) char() {
 	)
 }
 
-func (t *Tokenizer) numeric() {
-	for isDigit(t.peek()) {
-		t.advance()
+func isBinaryDigit(char rune) bool {
+	return char == '0' || char == '1'
+}
+
+func isOctalDigit(char rune) bool {
+	return char >= '0' && char <= '7'
+}
+
+func (t *Tokenizer) numericError(message string) error {
+	end := t.current
+	if !t.isAtEnd() {
+		end++
+	}
+
+	return exception.NewSyntaxError(message, t.line, t.column+1, string(t.source[t.beginOfLexeme:end]))
+}
+
+// consumes a run of digits where '_' may only sit between two digits
+func (t *Tokenizer) digits(valid func(rune) bool, consumed int) error {
+	for {
+		if valid(t.peek()) {
+			t.advance()
+			consumed++
+			continue
+		}
+
+		if t.peek() == '_' {
+			if consumed == 0 || !valid(t.peekNext()) {
+				return t.numericError("Misplaced digit separator")
+			}
+
+			t.advance()
+			continue
+		}
+
+		if consumed == 0 {
+			return t.numericError("Expected digit")
+		}
+
+		return nil
+	}
+}
+
+func (t *Tokenizer) numeric() error {
+	radixes := map[rune]func(rune) bool{
+		'x': isHexDigit,
+		'b': isBinaryDigit,
+		'o': isOctalDigit,
 	}
 
-	if t.peek() == '.' && isDigit(t.peekNext()) {
+	valid, prefixed := radixes[unicode.ToLower(t.peek())]
+
+	if t.previous() == '0' && prefixed {
 		t.advance()
 
-		for isDigit(t.peek()) {
+		if err := t.digits(valid, 0); err != nil {
+			return err
+		}
+	} else {
+		if err := t.digits(isDigit, 1); err != nil {
+			return err
+		}
+
+		if t.peek() == '.' && isDigit(t.peekNext()) {
+			t.advance()
+
+			if err := t.digits(isDigit, 0); err != nil {
+				return err
+			}
+		}
+
+		if t.peek() == 'e' || t.peek() == 'E' {
 			t.advance()
+
+			if t.peek() == '+' || t.peek() == '-' {
+				t.advance()
+			}
+
+			if err := t.digits(isDigit, 0); err != nil {
+				return err
+			}
 		}
 	}
 
-	lexeme := string([]rune(t.source)[t.beginOfLexeme:t.current])
+	if isAlphaNumeric(t.peek()) {
+		return t.numericError("Invalid numeric literal")
+	}
+
+	lexeme := string(t.source[t.beginOfLexeme:t.current])
 
 	t.add(
 		Token{
@@ -191,6 +457,8 @@ func (t *Tokenizer) numeric() {
 			},
 		},
 	)
+
+	return nil
 }
 
 func (t *Tokenizer) identifier() {
@@ -198,14 +466,14 @@ func (t *Tokenizer) identifier() {
 		t.advance()
 	}
 
-	keyword := getKeyword(string([]rune(t.source)[t.beginOfLexeme:t.current]))
+	keyword := getKeyword(string(t.source[t.beginOfLexeme:t.current]))
 
 	if keyword != UNKNOWN {
 		t.addNonLiteralToken(keyword)
 		return
 	}
 
-	lexeme := string([]rune(t.source)[t.beginOfLexeme:t.current])
+	lexeme := string(t.source[t.beginOfLexeme:t.current])
 
 	t.add(
 		Token{
@@ -247,15 +515,35 @@ func (t *Tokenizer) scan() error {
 	case ';':
 		t.addNonLiteralToken(SEMICOLON)
 	case '*':
-		t.addNonLiteralToken(STAR)
+		if t.match('*') {
+			t.addNonLiteralToken(STAR_STAR)
+		} else {
+			t.addNonLiteralToken(STAR)
+		}
 	case '/':
-		t.addNonLiteralToken(SLASH)
+		if t.match('/') {
+			t.addNonLiteralToken(SLASH_SLASH)
+		} else {
+			t.addNonLiteralToken(SLASH)
+		}
+	case '&':
+		t.addNonLiteralToken(AMPERSAND)
+	case '^':
+		t.addNonLiteralToken(CARET)
 	case '%':
 		t.addNonLiteralToken(PERCENT)
 	case '~':
 		t.addNonLiteralToken(TILDE)
 	case '?':
-		t.addNonLiteralToken(HOOK)
+		if t.match('.') {
+			t.addNonLiteralToken(HOOK_DOT)
+		} else if t.match('[') {
+			t.addNonLiteralToken(HOOK_BRACKET)
+		} else if t.match('?') {
+			t.addNonLiteralToken(HOOK_HOOK)
+		} else {
+			t.addNonLiteralToken(HOOK)
+		}
 	case '|':
 		t.addNonLiteralToken(PIPE)
 	case '-':
@@ -274,8 +562,10 @@ func (t *Tokenizer) scan() error {
 		if t.match('.') {
 			if t.match('.') {
 				t.addNonLiteralToken(VARIADIC)
+			} else if t.match('=') {
+				t.addNonLiteralToken(DOT_DOT_EQUAL)
 			} else {
-				return exception.NewSyntaxError("Unexpected double dot", t.line, t.column, string(char))
+				t.addNonLiteralToken(DOT_DOT)
 			}
 		} else {
 			t.addNonLiteralToken(DOT)
@@ -299,13 +589,17 @@ func (t *Tokenizer) scan() error {
 			t.addNonLiteralToken(EQUAL)
 		}
 	case '<':
-		if t.match('=') {
+		if t.match('<') {
+			t.addNonLiteralToken(LESS_LESS)
+		} else if t.match('=') {
 			t.addNonLiteralToken(LESS_THAN_EQUAL)
 		} else {
 			t.addNonLiteralToken(LESS_THAN)
 		}
 	case '>':
-		if t.match('=') {
+		if t.match('>') {
+			t.addNonLiteralToken(GREATER_GREATER)
+		} else if t.match('=') {
 			t.addNonLiteralToken(GREATER_THAN_EQUAL)
 		} else {
 			t.addNonLiteralToken(GREATER_THAN)
@@ -314,12 +608,15 @@ func (t *Tokenizer) scan() error {
 	case '\n':
 		t.advanceLine()
 	case '"':
-		t.string()
+		return t.string(false)
 	case '\'':
 		t.char()
 	default:
 		if isDigit(char) {
-			t.numeric()
+			return t.numeric()
+		} else if char == 'r' && t.peek() == '"' {
+			t.advance()
+			return t.string(true)
 		} else if isIdentifier(char) {
 			t.identifier()
 		} else {
@@ -337,7 +634,7 @@ func Tokenize(source string) ([]Token, error) {
 		beginOfLexeme: 0,
 		line:          1,
 		column:        0,
-		source:        source,
+		source:        []rune(source),
 	}
 
 	for !tokenizer.isAtEnd() {
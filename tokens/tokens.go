@@ -76,6 +76,31 @@ const (
 	PIPE               TokenType = "PIPE"
 	ENUMOF             TokenType = "ENUMOF"
 	IS                 TokenType = "IS"
+	STRUCT             TokenType = "STRUCT"
+	TRY                TokenType = "TRY"
+	CATCH              TokenType = "CATCH"
+	FINALLY            TokenType = "FINALLY"
+	THROW              TokenType = "THROW"
+	IN                 TokenType = "IN"
+	DOT_DOT            TokenType = "DOT_DOT"
+	DOT_DOT_EQUAL      TokenType = "DOT_DOT_EQUAL"
+	STEP               TokenType = "STEP"
+	TEMPLATE_START     TokenType = "TEMPLATE_START"
+	TEMPLATE_END       TokenType = "TEMPLATE_END"
+	INTERPOLATION      TokenType = "INTERPOLATION"
+	INTERPOLATION_END  TokenType = "INTERPOLATION_END"
+	AMPERSAND          TokenType = "AMPERSAND"
+	CARET              TokenType = "CARET"
+	LESS_LESS          TokenType = "LESS_LESS"
+	GREATER_GREATER    TokenType = "GREATER_GREATER"
+	SLASH_SLASH        TokenType = "SLASH_SLASH"
+	STAR_STAR          TokenType = "STAR_STAR"
+	HOOK_DOT           TokenType = "HOOK_DOT"
+	HOOK_BRACKET       TokenType = "HOOK_BRACKET"
+	HOOK_HOOK          TokenType = "HOOK_HOOK"
+	TYPE               TokenType = "TYPE"
+	INTERFACE          TokenType = "INTERFACE"
+	IMPL               TokenType = "IMPL"
 )
 
 var PRIMITIVE_TYPES = []TokenType{
@@ -90,7 +115,8 @@ var COMPLEX_TYPES = []TokenType{
 	ARR_TYPE,
 	ANY_TYPE,
 	FUN_TYPE,
-	IDENTIFIER, // enums
+	RANGE,
+	IDENTIFIER, // enums and structs
 }
 
 var DATA_TYPES = append(PRIMITIVE_TYPES, COMPLEX_TYPES...)
@@ -136,6 +162,17 @@ var reservedKeywordsMap = map[string]TokenType{
 	"match":    MATCH,
 	"enumof":   ENUMOF,
 	"is":       IS,
+	"struct":   STRUCT,
+	"try":      TRY,
+	"catch":    CATCH,
+	"finally":  FINALLY,
+	"throw":    THROW,
+	"in":       IN,
+	"step":     STEP,
+	"type":     TYPE,
+	"impl":     IMPL,
+
+	"interface": INTERFACE,
 }
 
 func getKeyword(lexis string) TokenType {
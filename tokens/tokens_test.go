@@ -34,3 +34,196 @@ func TestGenerateTokenSingleCases(t *testing.T) {
 		})
 	}
 }
+
+// tokenizes source dropping the trailing EOF token
+func tokenizeWithoutEOF(t *testing.T, source string) []Token {
+	t.Helper()
+
+	result, err := Tokenize(source)
+	if err != nil {
+		t.Fatalf("return an unexpected error: %v", err)
+	}
+
+	if len(result) == 0 || result[len(result)-1].Type != EOF {
+		t.Fatal("expected tokens to end with EOF")
+	}
+
+	return result[:len(result)-1]
+}
+
+func TestGenerateTemplateTokens(t *testing.T) {
+	var tests = []struct {
+		source string
+		want   []TokenType
+	}{
+		{`"a ${x} b"`, []TokenType{TEMPLATE_START, STRING, INTERPOLATION, IDENTIFIER, INTERPOLATION_END, STRING, TEMPLATE_END}},
+		{`"${x}"`, []TokenType{TEMPLATE_START, INTERPOLATION, IDENTIFIER, INTERPOLATION_END, TEMPLATE_END}},
+		{`"${~items} items"`, []TokenType{TEMPLATE_START, INTERPOLATION, TILDE, IDENTIFIER, INTERPOLATION_END, STRING, TEMPLATE_END}},
+		{`"cost $5"`, []TokenType{STRING}},
+	}
+
+	for _, testCase := range tests {
+		testName := fmt.Sprintf("should return types %v when lexis is %s", testCase.want, testCase.source)
+		t.Run(testName, func(t *testing.T) {
+			result := tokenizeWithoutEOF(t, testCase.source)
+
+			if len(result) != len(testCase.want) {
+				t.Fatalf("got %d tokens, want %d", len(result), len(testCase.want))
+			}
+
+			for i, token := range result {
+				if token.Type != testCase.want[i] {
+					t.Errorf("got %s at %d, want %s", token.Type, i, testCase.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateTemplateTokensErrors(t *testing.T) {
+	if _, err := Tokenize(`"a ${x"`); err == nil {
+		t.Error("expected an unterminated interpolation error")
+	}
+}
+
+func TestGenerateStringLiterals(t *testing.T) {
+	var tests = []struct {
+		source string
+		want   string
+	}{
+		{`"a\tb"`, "a\tb"},
+		{`"line\n"`, "line\n"},
+		{`"quote \" and \\"`, `quote " and \`},
+		{`"\x41"`, "A"},
+		{`"\u{1F600}"`, "\U0001F600"},
+		{`"\$"`, "$"},
+		{`r"a\n${x}"`, `a\n${x}`},
+		{"\"\"\"first\nsecond\"\"\"", "first\nsecond"},
+		{"\"\"\"say \"hi\" twice\"\"\"", `say "hi" twice`},
+	}
+
+	for _, testCase := range tests {
+		testName := fmt.Sprintf("should decode %s", testCase.source)
+		t.Run(testName, func(t *testing.T) {
+			result := tokenizeWithoutEOF(t, testCase.source)
+
+			if len(result) != 1 || result[0].Type != STRING {
+				t.Fatalf("got %v, want a single STRING", result)
+			}
+
+			if result[0].Lexeme != testCase.want {
+				t.Errorf("got %q, want %q", result[0].Lexeme, testCase.want)
+			}
+		})
+	}
+}
+
+func TestGenerateStringLiteralsErrors(t *testing.T) {
+	var tests = []string{
+		`"\q"`,
+		`"\x4"`,
+		`"\u{110000}"`,
+		`"\u41"`,
+	}
+
+	for _, source := range tests {
+		testName := fmt.Sprintf("should reject %s", source)
+		t.Run(testName, func(t *testing.T) {
+			if _, err := Tokenize(source); err == nil {
+				t.Error("expected an invalid escape sequence error")
+			}
+		})
+	}
+}
+
+func TestGenerateNumericLiterals(t *testing.T) {
+	var tests = []string{
+		"0xFF",
+		"0XfF",
+		"0b1010",
+		"0o17",
+		"1_000_000",
+		"1.5e-3",
+		"2E10",
+		"1_000.000_1e1_0",
+	}
+
+	for _, source := range tests {
+		testName := fmt.Sprintf("should return type NUMERIC when lexis is %s", source)
+		t.Run(testName, func(t *testing.T) {
+			result := tokenizeWithoutEOF(t, source)
+
+			if len(result) != 1 || result[0].Type != NUMERIC {
+				t.Fatalf("got %v, want a single NUMERIC", result)
+			}
+
+			if result[0].Lexeme != source {
+				t.Errorf("got %s, want %s", result[0].Lexeme, source)
+			}
+		})
+	}
+}
+
+func TestGenerateNumericLiteralsErrors(t *testing.T) {
+	var tests = []string{
+		"0x",
+		"0o8",
+		"0b102",
+		"1__0",
+		"1_",
+		"1e",
+		"1.5e+",
+		"12abc",
+	}
+
+	for _, source := range tests {
+		testName := fmt.Sprintf("should reject %s", source)
+		t.Run(testName, func(t *testing.T) {
+			if _, err := Tokenize(source); err == nil {
+				t.Error("expected a malformed numeric literal error")
+			}
+		})
+	}
+}
+
+func TestGenerateOperatorTokens(t *testing.T) {
+	var tests = []struct {
+		source string
+		want   TokenType
+	}{
+		{"&", AMPERSAND},
+		{"|", PIPE},
+		{"^", CARET},
+		{"<<", LESS_LESS},
+		{">>", GREATER_GREATER},
+		{"//", SLASH_SLASH},
+		{"**", STAR_STAR},
+		{"/", SLASH},
+		{"*", STAR},
+		{"<", LESS_THAN},
+		{"<=", LESS_THAN_EQUAL},
+		{">", GREATER_THAN},
+		{">=", GREATER_THAN_EQUAL},
+		{"?.", HOOK_DOT},
+		{"??", HOOK_HOOK},
+		{"?", HOOK},
+		{"..", DOT_DOT},
+		{"..=", DOT_DOT_EQUAL},
+		{"...", VARIADIC},
+	}
+
+	for _, testCase := range tests {
+		testName := fmt.Sprintf("should return type %s when lexis is %s", testCase.want, testCase.source)
+		t.Run(testName, func(t *testing.T) {
+			result := tokenizeWithoutEOF(t, testCase.source)
+
+			if len(result) != 1 {
+				t.Fatalf("got %v, want a single token", result)
+			}
+
+			if result[0].Type != testCase.want {
+				t.Errorf("got %s, want %s", result[0].Type, testCase.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,11 @@ func isFunctionDeclaration(value interface{}) bool {
 	return reflect.TypeOf(value).Name() == "FunctionDeclaration"
 }
 
+func isRange(value interface{}) bool {
+	// same as isFunctionDeclaration, ranges live in the interpreter package
+	return reflect.TypeOf(value).Name() == "Range"
+}
+
 func CheckPrimitiveType(targetType UmbraType, expected interface{}, nullable bool, node globals.Node) error {
 	if targetType == ANY {
 		return nil
@@ -54,6 +59,12 @@ func CheckPrimitiveType(targetType UmbraType, expected interface{}, nullable boo
 				return nil
 			}
 		}
+
+		if isRange(expected) {
+			if targetType == RANGE {
+				return nil
+			}
+		}
 	}
 
 	expectedType, err := ParseUmbraType(expected)
@@ -82,7 +93,11 @@ func ParseUmbraType(value interface{}) (UmbraType, error) {
 	case []interface{}:
 		return ARR, nil
 	default:
-		if isFunctionDeclaration("FunctionDeclaration") {
+		if isRange(value) {
+			return RANGE, nil
+		}
+
+		if isFunctionDeclaration(value) {
 			return FUN, nil
 		}
 
@@ -118,6 +133,8 @@ func ParseTokenType(value tokens.TokenType) (UmbraType, error) {
 		return VOID, nil
 	case tokens.FUN_TYPE:
 		return FUN, nil
+	case tokens.RANGE:
+		return RANGE, nil
 	default:
 		return UNKNOWN, exception.NewUmbraError("TY000", nil, value)
 	}
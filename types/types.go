@@ -1,5 +1,7 @@
 package types
 
+import "github.com/pmqueiroz/umbra/globals"
+
 type UmbraType string
 
 const (
@@ -13,6 +15,20 @@ const (
 	ANY     UmbraType = "<any>"
 	NULL    UmbraType = "<null>"
 	ENUM    UmbraType = "<enum>"
+	STRUCT  UmbraType = "<struct>"
+	RANGE   UmbraType = "<range>"
+	UNION   UmbraType = "<union>"
 	UNKNOWN UmbraType = "<unknown>"
 	VOID    UmbraType = "<void>"
+
+	INTERFACE UmbraType = "<interface>"
 )
+
+type RuntimeType struct {
+	Type      UmbraType
+	Parent    globals.Node
+	Arguments []RuntimeType
+	Return    *RuntimeType
+	Members   []RuntimeType
+	Nullable  bool
+}